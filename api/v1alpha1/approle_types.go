@@ -54,17 +54,85 @@ type AppRoleSpec struct {
 	// +optional
 	// +kubebuilder:default=3600
 	SecretIDTTL int `json:"secret_id_ttl,omitempty"`
-	// Export secret to namespace
+	// RoleID sets a custom role_id. Empty keeps the one generated by Vault.
+	// +optional
+	RoleID string `json:"role_id,omitempty"`
+	// BindSecretID requires a secret-id on login. Defaults to true.
+	// +optional
+	BindSecretID *bool `json:"bind_secret_id,omitempty"`
+	// +optional
+	SecretIDBoundCIDRs []string `json:"secret_id_bound_cidrs,omitempty"`
+	// SecretIDNumUses limits the logins per secret-id, zero meaning unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SecretIDNumUses int32 `json:"secret_id_num_uses,omitempty"`
+	// TokenSettings configures the tokens issued on login.
+	// +optional
+	TokenSettings *TokenSettings `json:"token_settings,omitempty"`
+	// Export secret to namespace. Kept for compatibility, prefer Exports.
 	// +optional
 	Export *Export `json:"export,omitempty"`
+	// Exports are Secrets receiving the role_id and a secret-id of their own.
+	// +optional
+	Exports []Export `json:"exports,omitempty"`
+	// SecretIDRenewAtPercent is the share of the secret-id TTL, in percent,
+	// after which a new secret-id is issued and exported.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=66
+	// +optional
+	SecretIDRenewAtPercent int32 `json:"secret_id_renew_at_percent,omitempty"`
+	// SecretIDGracePeriod keeps a replaced secret-id valid for clients still
+	// using it before it is destroyed.
+	// +kubebuilder:default="10m"
+	// +optional
+	SecretIDGracePeriod *metav1.Duration `json:"secret_id_grace_period,omitempty"`
 
 }
 
 type Export struct {
+	// Name of the exported Secret. Defaults to approle-<AppRole name>-secret.
+	// +optional
+	Name string `json:"name,omitempty"`
 	// +kubebuilder:validation:Required
 	Namespace string `json:"namespace"`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// RoleIDKey is the Secret key holding the role_id.
+	// +kubebuilder:default=role_id
+	// +optional
+	RoleIDKey string `json:"roleIdKey,omitempty"`
+	// SecretIDKey is the Secret key holding the secret-id, or the wrapping
+	// token in Wrapped mode. Defaults to secret_id or wrapping_token.
+	// +optional
+	SecretIDKey string `json:"secretIdKey,omitempty"`
+	// Mode selects what is exported next to the role_id: the plain secret_id,
+	// or with Wrapped only a wrapping_token that unwraps to it once.
+	// +kubebuilder:validation:Enum=SecretID;Wrapped
+	// +kubebuilder:default=SecretID
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// WrapTTL is how long the wrapping token stays valid. A token expiring
+	// unused gets its secret-id destroyed and a new one issued.
+	// +kubebuilder:default="1h"
+	// +optional
+	WrapTTL *metav1.Duration `json:"wrapTtl,omitempty"`
 }
 
+const (
+	ExportModeSecretID = "SecretID"
+	ExportModeWrapped  = "Wrapped"
+)
+
+// Exported Secrets carry these labels so they can be found and cleaned up,
+// owner references not being possible across namespaces.
+const (
+	AppRoleNameLabel      = "vault.ops.community.dev/approle-name"
+	AppRoleNamespaceLabel = "vault.ops.community.dev/approle-namespace"
+)
+
 // AppRoleStatus defines the observed state of AppRole.
 type AppRoleStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -93,6 +161,43 @@ type AppRoleStatus struct {
 	Message string `json:"message,omitempty"`
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Exports tracks the secret-id exported to each target.
+	// +optional
+	Exports []ExportStatus `json:"exports,omitempty"`
+	// RetiredSecretIDs are replaced secret-ids waiting for their grace period
+	// to end before they are destroyed.
+	// +optional
+	RetiredSecretIDs []RetiredSecretID `json:"retiredSecretIds,omitempty"`
+	// LastDriftCorrection is when the role was last rewritten after being
+	// changed outside the operator.
+	// +optional
+	LastDriftCorrection *metav1.Time `json:"lastDriftCorrection,omitempty"`
+}
+
+// ExportStatus is the state of one exported Secret.
+type ExportStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// SecretIDAccessor is the accessor of the exported secret-id.
+	// +optional
+	SecretIDAccessor string `json:"secretIdAccessor,omitempty"`
+	// SecretIDRenewalTime is when a new secret-id will be issued. Unset for
+	// secret-ids without a TTL.
+	// +optional
+	SecretIDRenewalTime *metav1.Time `json:"secretIdRenewalTime,omitempty"`
+	// WrappingTokenExpiration is when the exported wrapping token expires.
+	// +optional
+	WrappingTokenExpiration *metav1.Time `json:"wrappingTokenExpiration,omitempty"`
+	// SecretIDUnwrapped reports whether the exported wrapping token was unwrapped.
+	// +optional
+	SecretIDUnwrapped bool `json:"secretIdUnwrapped,omitempty"`
+}
+
+// RetiredSecretID is a replaced secret-id scheduled for destruction.
+type RetiredSecretID struct {
+	Accessor     string      `json:"accessor"`
+	DestroyAfter metav1.Time `json:"destroyAfter"`
 }
 
 // +kubebuilder:object:root=true
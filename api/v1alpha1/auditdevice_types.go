@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// AuditDeviceSpec defines the desired state of AuditDevice
+type AuditDeviceSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// +kubebuilder:validation:Required
+	VaultServer *VaultOperatorInstance `json:"vaultOperator"`
+
+	// Type of the audit device. Changing it disables the device and enables it again.
+	// +kubebuilder:validation:Enum=file;socket;syslog
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Path the device is enabled at, defaults to the type.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Local devices are not replicated to performance secondaries.
+	// +optional
+	Local bool `json:"local,omitempty"`
+
+	// FilePath is where a file device writes to, "stdout" and "discard" are
+	// also accepted.
+	// +optional
+	FilePath string `json:"filePath,omitempty"`
+	// Mode is the octal permission of the log file, e.g. "0600".
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Address and SocketType configure a socket device.
+	// +optional
+	Address string `json:"address,omitempty"`
+	// +kubebuilder:validation:Enum=tcp;udp;unix
+	// +optional
+	SocketType string `json:"socketType,omitempty"`
+
+	// Facility and Tag configure a syslog device.
+	// +optional
+	Facility string `json:"facility,omitempty"`
+	// +optional
+	Tag string `json:"tag,omitempty"`
+
+	// LogRaw logs sensitive values without hashing them. Only meant for debugging.
+	// +optional
+	LogRaw bool `json:"logRaw,omitempty"`
+	// HMACAccessor hashes token accessors, Vault defaults it to true.
+	// +optional
+	HMACAccessor *bool `json:"hmacAccessor,omitempty"`
+	// +kubebuilder:validation:Enum=json;jsonx
+	// +optional
+	Format string `json:"format,omitempty"`
+	// Prefix is prepended to every log line.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Options holds additional device options passed to Vault as is.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// AuditDeviceStatus defines the observed state of AuditDevice.
+type AuditDeviceStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the AuditDevice resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Path is where the device was last enabled, used to disable it when
+	// spec.path changes.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AuditDevice is the Schema for the auditdevices API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Path",type=string,JSONPath=".status.path"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type AuditDevice struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of AuditDevice
+	// +required
+	Spec AuditDeviceSpec `json:"spec"`
+
+	// status defines the observed state of AuditDevice
+	// +optional
+	Status AuditDeviceStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuditDeviceList contains a list of AuditDevice
+type AuditDeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []AuditDevice `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AuditDevice{}, &AuditDeviceList{})
+}
@@ -38,6 +38,12 @@ type AuthMethodSpec struct {
 
 	// +kubebuilder:validation:Required
 	Type string `json:"type,omitempty"`
+
+	// PathChangePolicy decides how a change of path is applied to the mount
+	// created under the previous path.
+	// +kubebuilder:default=Remount
+	// +optional
+	PathChangePolicy PathChangePolicy `json:"pathChangePolicy,omitempty"`
 }
 
 // AuthMethodStatus defines the observed state of AuthMethod.
@@ -68,6 +74,21 @@ type AuthMethodStatus struct {
 	Message string `json:"message,omitempty"`
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// MountedPath is the path the auth method is currently mounted at by the operator.
+	// +optional
+	MountedPath string `json:"mountedPath,omitempty"`
+	// MigrationID is the id of the last remount started by the operator, kept
+	// until the migration finishes.
+	// +optional
+	MigrationID string `json:"migrationId,omitempty"`
+	// MigrationStatus is the last state reported for the remount: in-progress, success or failure.
+	// +optional
+	MigrationStatus string `json:"migrationStatus,omitempty"`
+	// MigrationGeneration is the generation of the spec the last remount was
+	// started for. A failed remount is not retried until the spec changes.
+	// +optional
+	MigrationGeneration int64 `json:"migrationGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AuthMethodReference points to an AuthMethod in the same namespace.
+// The referenced AuthMethod provides both the vault server and the mount path.
+type AuthMethodReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// SecretEngineReference points to a SecretEngine in the same namespace.
+// The referenced SecretEngine provides both the vault server and the mount path.
+type SecretEngineReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// PKIRoleReference points to a PKIRole in the same namespace.
+// The referenced PKIRole provides the secret engine and the role name.
+type PKIRoleReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// DatabaseConnectionReference points to a DatabaseConnection in the same namespace.
+// The referenced DatabaseConnection provides the secret engine and the connection name.
+type DatabaseConnectionReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// IdentityEntityReference points to an IdentityEntity in the same namespace.
+// The referenced IdentityEntity provides the vault server and the entity id.
+type IdentityEntityReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// IdentityGroupReference points to an IdentityGroup in the same namespace.
+type IdentityGroupReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// SecretKeyReference selects a key of a Kubernetes Secret in the same namespace.
+type SecretKeyReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// TokenSettings holds the common token_* parameters accepted by Vault auth roles.
+// Durations use the Vault format, e.g. "3600", "1h" or "30m".
+type TokenSettings struct {
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+	// +optional
+	MaxTTL string `json:"maxTtl,omitempty"`
+	// +optional
+	ExplicitMaxTTL string `json:"explicitMaxTtl,omitempty"`
+	// +optional
+	Period string `json:"period,omitempty"`
+	// +optional
+	BoundCIDRs []string `json:"boundCidrs,omitempty"`
+	// +optional
+	NumUses int32 `json:"numUses,omitempty"`
+	// +kubebuilder:validation:Enum=default;service;batch;default-service;default-batch
+	// +optional
+	Type string `json:"type,omitempty"`
+	// +optional
+	NoDefaultPolicy bool `json:"noDefaultPolicy,omitempty"`
+}
+
+// ConditionReady is set on resources that report their Vault state through
+// conditions. A False status with one of the blocking reasons below means the
+// operator will not act until the conflict is resolved by hand.
+const ConditionReady = "Ready"
+
+const (
+	ReasonSynchronized   = "Synchronized"
+	ReasonFailed         = "Failed"
+	ReasonTypeMismatch   = "TypeMismatch"
+	ReasonPathConflict   = "PathConflict"
+	ReasonRemounting     = "Remounting"
+	ReasonRemountFailed  = "RemountFailed"
+	ReasonDriftCorrected = "DriftCorrected"
+)
+
+// ConditionAuditDeviceEnabled is set on VaultServers to warn when Vault
+// answers requests without any audit device enabled.
+const ConditionAuditDeviceEnabled = "AuditDeviceEnabled"
+
+const (
+	ReasonAuditDevicesFound = "AuditDevicesFound"
+	ReasonNoAuditDevice     = "NoAuditDevice"
+)
+
+// PathChangePolicy tells the operator what to do with the old mount when the
+// path of a mount is changed.
+// +kubebuilder:validation:Enum=Remount;Disable
+type PathChangePolicy string
+
+const (
+	// PathChangeRemount moves the mount with sys/remount, keeping its data.
+	PathChangeRemount PathChangePolicy = "Remount"
+	// PathChangeDisable disables the old mount, dropping all of its data,
+	// and enables a fresh one at the new path.
+	PathChangeDisable PathChangePolicy = "Disable"
+)
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DatabaseConnectionSpec defines the desired state of DatabaseConnection
+type DatabaseConnectionSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type database) holding the connection.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Name is the connection name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// PluginName is the database plugin, e.g. postgresql-database-plugin.
+	// +kubebuilder:validation:Required
+	PluginName string `json:"pluginName"`
+	// +optional
+	PluginVersion string `json:"pluginVersion,omitempty"`
+
+	// ConnectionURL is the plugin connection string. It usually holds the
+	// {{username}} and {{password}} templates.
+	// +kubebuilder:validation:Required
+	ConnectionURL string `json:"connectionUrl"`
+
+	// CredentialsSecretRef selects the Secret holding the connection user.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef DatabaseCredentialsSecretReference `json:"credentialsSecretRef"`
+
+	// AllowedRoles lists the roles allowed to use this connection, "*" for all.
+	// +optional
+	AllowedRoles []string `json:"allowedRoles,omitempty"`
+
+	// +kubebuilder:default=true
+	// +optional
+	VerifyConnection *bool `json:"verifyConnection,omitempty"`
+
+	// +optional
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+
+	// +optional
+	RootRotationStatements []string `json:"rootRotationStatements,omitempty"`
+
+	// RotateRootCredentials rotates the connection user password once the
+	// connection is configured, and again whenever the credentials Secret
+	// changes. Afterwards only Vault knows the password.
+	// +optional
+	RotateRootCredentials bool `json:"rotateRootCredentials,omitempty"`
+}
+
+// DatabaseCredentialsSecretReference selects the username and password keys
+// of a Kubernetes Secret in the same namespace.
+type DatabaseCredentialsSecretReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:default=username
+	// +optional
+	UsernameKey string `json:"usernameKey,omitempty"`
+	// +kubebuilder:default=password
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
+// DatabaseConnectionStatus defines the observed state of DatabaseConnection.
+type DatabaseConnectionStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the DatabaseConnection resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// CredentialsVersion is the resourceVersion of the credentials Secret last
+	// written to Vault.
+	// +optional
+	CredentialsVersion string `json:"credentialsVersion,omitempty"`
+	// RootRotationTime is when the root credentials were last rotated.
+	// +optional
+	RootRotationTime *metav1.Time `json:"rootRotationTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DatabaseConnection is the Schema for the databaseconnections API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type DatabaseConnection struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of DatabaseConnection
+	// +required
+	Spec DatabaseConnectionSpec `json:"spec"`
+
+	// status defines the observed state of DatabaseConnection
+	// +optional
+	Status DatabaseConnectionStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseConnectionList contains a list of DatabaseConnection
+type DatabaseConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []DatabaseConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatabaseConnection{}, &DatabaseConnectionList{})
+}
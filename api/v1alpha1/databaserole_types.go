@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DatabaseRoleSpec defines the desired state of DatabaseRole
+type DatabaseRoleSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// Connection references the DatabaseConnection the credentials are created on.
+	// +kubebuilder:validation:Required
+	Connection DatabaseConnectionReference `json:"connection"`
+
+	// Name is the role name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// CreationStatements create the dynamic user, using the {{name}},
+	// {{password}} and {{expiration}} templates.
+	// +kubebuilder:validation:MinItems=1
+	CreationStatements []string `json:"creationStatements"`
+	// +optional
+	RevocationStatements []string `json:"revocationStatements,omitempty"`
+	// +optional
+	RollbackStatements []string `json:"rollbackStatements,omitempty"`
+	// +optional
+	RenewStatements []string `json:"renewStatements,omitempty"`
+
+	// +optional
+	DefaultTTL string `json:"defaultTtl,omitempty"`
+	// +optional
+	MaxTTL string `json:"maxTtl,omitempty"`
+
+	// +kubebuilder:validation:Enum=password;rsa_private_key;client_certificate
+	// +optional
+	CredentialType string `json:"credentialType,omitempty"`
+}
+
+// DatabaseRoleStatus defines the observed state of DatabaseRole.
+type DatabaseRoleStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the DatabaseRole resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DatabaseRole is the Schema for the databaseroles API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type DatabaseRole struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of DatabaseRole
+	// +required
+	Spec DatabaseRoleSpec `json:"spec"`
+
+	// status defines the observed state of DatabaseRole
+	// +optional
+	Status DatabaseRoleStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseRoleList contains a list of DatabaseRole
+type DatabaseRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []DatabaseRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatabaseRole{}, &DatabaseRoleList{})
+}
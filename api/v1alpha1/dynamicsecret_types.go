@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DynamicSecretSpec defines the desired state of DynamicSecret
+type DynamicSecretSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine serving the dynamic path.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Path is the dynamic path relative to the secret engine mount, e.g. creds/readonly.
+	// +kubebuilder:validation:Required
+	Path string `json:"path"`
+
+	// Method is read for endpoints like database/creds/<role>, write for
+	// endpoints that take parameters, like aws/sts/<role>.
+	// +kubebuilder:validation:Enum=read;write
+	// +kubebuilder:default=read
+	// +optional
+	Method string `json:"method,omitempty"`
+	// Parameters are sent as the body of a write.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// SecretName is the Secret receiving the returned data, one key per field.
+	// Defaults to the name of the DynamicSecret.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// RenewAtPercent is the share of the lease duration, in percent, after
+	// which the lease is renewed.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=66
+	// +optional
+	RenewAtPercent int32 `json:"renewAtPercent,omitempty"`
+	// RevokeGracePeriod keeps a replaced lease valid for pods still using the
+	// previous credentials before it is revoked.
+	// +kubebuilder:default="10m"
+	// +optional
+	RevokeGracePeriod *metav1.Duration `json:"revokeGracePeriod,omitempty"`
+}
+
+// DynamicSecretStatus defines the observed state of DynamicSecret.
+type DynamicSecretStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the DynamicSecret resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// +optional
+	LeaseID string `json:"leaseId,omitempty"`
+	// LeaseDuration is the duration in seconds granted when the secret was read.
+	// +optional
+	LeaseDuration int64 `json:"leaseDuration,omitempty"`
+	// +optional
+	Renewable bool `json:"renewable,omitempty"`
+	// IssueTime is when the secret was last read from Vault.
+	// +optional
+	IssueTime *metav1.Time `json:"issueTime,omitempty"`
+	// LeaseExpiry is when the current lease expires unless renewed.
+	// +optional
+	LeaseExpiry *metav1.Time `json:"leaseExpiry,omitempty"`
+	// RenewalTime is when the lease will be renewed next.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+	// IssuedGeneration is the generation of the spec the current secret was read for.
+	// +optional
+	IssuedGeneration int64 `json:"issuedGeneration,omitempty"`
+	// IssuedSpecHash is the hash of the spec fields the current secret was
+	// read with: secret engine, path, method and parameters. Changing other
+	// fields does not read the secret again.
+	// +optional
+	IssuedSpecHash string `json:"issuedSpecHash,omitempty"`
+	// RetiredLeases are replaced leases waiting for their grace period to
+	// end before they are revoked.
+	// +optional
+	RetiredLeases []RetiredLease `json:"retiredLeases,omitempty"`
+}
+
+// RetiredLease is a replaced lease scheduled for revocation.
+type RetiredLease struct {
+	LeaseID     string      `json:"leaseId"`
+	RevokeAfter metav1.Time `json:"revokeAfter"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DynamicSecret is the Schema for the dynamicsecrets API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=".status.leaseExpiry"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type DynamicSecret struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of DynamicSecret
+	// +required
+	Spec DynamicSecretSpec `json:"spec"`
+
+	// status defines the observed state of DynamicSecret
+	// +optional
+	Status DynamicSecretStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// DynamicSecretList contains a list of DynamicSecret
+type DynamicSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []DynamicSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamicSecret{}, &DynamicSecretList{})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// IdentityGroupSpec defines the desired state of IdentityGroup
+type IdentityGroupSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// +kubebuilder:validation:Required
+	VaultServer *VaultOperatorInstance `json:"vaultOperator"`
+
+	// Name is the group name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type is internal for groups with explicit members or external for
+	// groups whose membership comes from an auth method, see Alias. The type
+	// of an existing group cannot be changed.
+	// +kubebuilder:validation:Enum=internal;external
+	// +kubebuilder:default=internal
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// MemberEntities and MemberGroups list the members of an internal group.
+	// +optional
+	MemberEntities []IdentityEntityReference `json:"memberEntities,omitempty"`
+	// +optional
+	MemberGroups []IdentityGroupReference `json:"memberGroups,omitempty"`
+
+	// Alias maps an external group to a group reported by an auth method,
+	// e.g. an LDAP group or a value of the JWT groups claim.
+	// +optional
+	Alias *IdentityGroupAlias `json:"alias,omitempty"`
+}
+
+// IdentityGroupAlias binds an external group to a group name of an auth method.
+type IdentityGroupAlias struct {
+	// +kubebuilder:validation:Required
+	AuthMethod AuthMethodReference `json:"authMethod"`
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// IdentityGroupStatus defines the observed state of IdentityGroup.
+type IdentityGroupStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the IdentityGroup resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// GroupID is the id Vault assigned to the group.
+	// +optional
+	GroupID string `json:"groupId,omitempty"`
+	// AliasID is the id of the group alias of an external group.
+	// +optional
+	AliasID string `json:"aliasId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// IdentityGroup is the Schema for the identitygroups API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type IdentityGroup struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of IdentityGroup
+	// +required
+	Spec IdentityGroupSpec `json:"spec"`
+
+	// status defines the observed state of IdentityGroup
+	// +optional
+	Status IdentityGroupStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityGroupList contains a list of IdentityGroup
+type IdentityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []IdentityGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IdentityGroup{}, &IdentityGroupList{})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// JWTAuthConfigSpec defines the desired state of JWTAuthConfig
+type JWTAuthConfigSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// AuthMethod references the AuthMethod (type jwt or oidc) to configure.
+	// +kubebuilder:validation:Required
+	AuthMethod AuthMethodReference `json:"authMethod"`
+
+	// OIDCDiscoveryURL enables OIDC discovery of the signing keys.
+	// Exactly one of oidcDiscoveryUrl, jwksUrl or jwtValidationPubkeys must be set.
+	// +optional
+	OIDCDiscoveryURL string `json:"oidcDiscoveryUrl,omitempty"`
+	// +optional
+	OIDCDiscoveryCAPEM string `json:"oidcDiscoveryCaPem,omitempty"`
+	// +optional
+	OIDCClientID string `json:"oidcClientId,omitempty"`
+	// OIDCClientSecretRef selects the OIDC client secret, required for oidc roles.
+	// +optional
+	OIDCClientSecretRef *SecretKeyReference `json:"oidcClientSecretRef,omitempty"`
+
+	// JWKSURL is a JSON Web Key Set endpoint used to verify token signatures.
+	// +optional
+	JWKSURL string `json:"jwksUrl,omitempty"`
+	// +optional
+	JWKSCAPEM string `json:"jwksCaPem,omitempty"`
+
+	// JWTValidationPubkeys are static PEM encoded public keys used to verify tokens.
+	// +optional
+	JWTValidationPubkeys []string `json:"jwtValidationPubkeys,omitempty"`
+	// +optional
+	JWTSupportedAlgs []string `json:"jwtSupportedAlgs,omitempty"`
+
+	// BoundIssuer is the value the iss claim must match.
+	// +optional
+	BoundIssuer string `json:"boundIssuer,omitempty"`
+	// DefaultRole is used when a login request does not name a role.
+	// +optional
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
+
+// JWTAuthConfigStatus defines the observed state of JWTAuthConfig.
+type JWTAuthConfigStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the JWTAuthConfig resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// JWTAuthConfig is the Schema for the jwtauthconfigs API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type JWTAuthConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of JWTAuthConfig
+	// +required
+	Spec JWTAuthConfigSpec `json:"spec"`
+
+	// status defines the observed state of JWTAuthConfig
+	// +optional
+	Status JWTAuthConfigStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTAuthConfigList contains a list of JWTAuthConfig
+type JWTAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []JWTAuthConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&JWTAuthConfig{}, &JWTAuthConfigList{})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// KubernetesAuthRoleSpec defines the desired state of KubernetesAuthRole
+type KubernetesAuthRoleSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// AuthMethod references the AuthMethod (type kubernetes) holding the role.
+	// +kubebuilder:validation:Required
+	AuthMethod AuthMethodReference `json:"authMethod"`
+
+	// Name is the role name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	BoundServiceAccountNames []string `json:"boundServiceAccountNames"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	BoundServiceAccountNamespaces []string `json:"boundServiceAccountNamespaces"`
+
+	// Audience is the optional audience claim to verify in the JWT.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// +kubebuilder:validation:Enum=serviceaccount_uid;serviceaccount_name
+	// +optional
+	AliasNameSource string `json:"aliasNameSource,omitempty"`
+
+	// +kubebuilder:default:={"default"}
+	// +optional
+	Policies []string `json:"policies"`
+
+	// +optional
+	TokenSettings *TokenSettings `json:"tokenSettings,omitempty"`
+}
+
+// KubernetesAuthRoleStatus defines the observed state of KubernetesAuthRole.
+type KubernetesAuthRoleStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the KubernetesAuthRole resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KubernetesAuthRole is the Schema for the kubernetesauthroles API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type KubernetesAuthRole struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of KubernetesAuthRole
+	// +required
+	Spec KubernetesAuthRoleSpec `json:"spec"`
+
+	// status defines the observed state of KubernetesAuthRole
+	// +optional
+	Status KubernetesAuthRoleStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesAuthRoleList contains a list of KubernetesAuthRole
+type KubernetesAuthRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []KubernetesAuthRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KubernetesAuthRole{}, &KubernetesAuthRoleList{})
+}
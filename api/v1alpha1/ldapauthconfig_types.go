@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// LDAPAuthConfigSpec defines the desired state of LDAPAuthConfig
+type LDAPAuthConfigSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// AuthMethod references the AuthMethod (type ldap) to configure.
+	// +kubebuilder:validation:Required
+	AuthMethod AuthMethodReference `json:"authMethod"`
+
+	// URL is the LDAP server address, e.g. ldap://ldap.example.com:389.
+	// Multiple comma separated URLs are tried in order.
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// BindDN is the distinguished name used to search for users.
+	// +optional
+	BindDN string `json:"bindDn,omitempty"`
+	// BindPassSecretRef selects the password of BindDN.
+	// +optional
+	BindPassSecretRef *SecretKeyReference `json:"bindPassSecretRef,omitempty"`
+
+	// UserDN is the base DN under which users are searched.
+	// +optional
+	UserDN string `json:"userDn,omitempty"`
+	// +kubebuilder:default=cn
+	// +optional
+	UserAttr string `json:"userAttr,omitempty"`
+	// +optional
+	UserFilter string `json:"userFilter,omitempty"`
+	// +optional
+	UPNDomain string `json:"upnDomain,omitempty"`
+	// DiscoverDN searches for the user DN using an anonymous or BindDN bind.
+	// +optional
+	DiscoverDN bool `json:"discoverDn,omitempty"`
+
+	// GroupDN is the base DN under which groups are searched.
+	// +optional
+	GroupDN string `json:"groupDn,omitempty"`
+	// +optional
+	GroupAttr string `json:"groupAttr,omitempty"`
+	// +optional
+	GroupFilter string `json:"groupFilter,omitempty"`
+
+	// +optional
+	TLS *LDAPTLSConfig `json:"tls,omitempty"`
+}
+
+// LDAPTLSConfig holds the TLS options used to reach the LDAP server.
+type LDAPTLSConfig struct {
+	// StartTLS upgrades a plain ldap:// connection with StartTLS.
+	// +optional
+	StartTLS bool `json:"startTls,omitempty"`
+	// InsecureSkipVerify disables certificate verification. Do not use in production.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// CACert is the PEM encoded CA certificate used to verify the server.
+	// +optional
+	CACert string `json:"caCert,omitempty"`
+	// +kubebuilder:validation:Enum=tls10;tls11;tls12;tls13
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+	// +kubebuilder:validation:Enum=tls10;tls11;tls12;tls13
+	// +optional
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// LDAPAuthConfigStatus defines the observed state of LDAPAuthConfig.
+type LDAPAuthConfigStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the LDAPAuthConfig resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LDAPAuthConfig is the Schema for the ldapauthconfigs API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type LDAPAuthConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of LDAPAuthConfig
+	// +required
+	Spec LDAPAuthConfigSpec `json:"spec"`
+
+	// status defines the observed state of LDAPAuthConfig
+	// +optional
+	Status LDAPAuthConfigStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPAuthConfigList contains a list of LDAPAuthConfig
+type LDAPAuthConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []LDAPAuthConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LDAPAuthConfig{}, &LDAPAuthConfigList{})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// LeaseCountQuotaSpec defines the desired state of LeaseCountQuota
+type LeaseCountQuotaSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// +kubebuilder:validation:Required
+	VaultServer *VaultOperatorInstance `json:"vaultOperator"`
+
+	// Name is the quota name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Path scopes the quota to a namespace, mount or mount subpath, e.g.
+	// "database". Empty applies it globally.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Role scopes the quota to logins with one role of the auth method at Path.
+	// +optional
+	Role string `json:"role,omitempty"`
+	// +optional
+	Inheritable bool `json:"inheritable,omitempty"`
+
+	// MaxLeases is the maximum number of leases allowed under Path.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	MaxLeases int64 `json:"maxLeases"`
+}
+
+// LeaseCountQuotaStatus defines the observed state of LeaseCountQuota.
+type LeaseCountQuotaStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the LeaseCountQuota resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// LastDriftCorrection is the last time the quota in Vault was found to
+	// differ from the spec and was rewritten.
+	// +optional
+	LastDriftCorrection *metav1.Time `json:"lastDriftCorrection,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LeaseCountQuota is the Schema for the leasecountquotas API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Max Leases",type=integer,JSONPath=".spec.maxLeases"
+// +kubebuilder:printcolumn:name="Path",type=string,JSONPath=".spec.path"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type LeaseCountQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of LeaseCountQuota
+	// +required
+	Spec LeaseCountQuotaSpec `json:"spec"`
+
+	// status defines the observed state of LeaseCountQuota
+	// +optional
+	Status LeaseCountQuotaStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// LeaseCountQuotaList contains a list of LeaseCountQuota
+type LeaseCountQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []LeaseCountQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LeaseCountQuota{}, &LeaseCountQuotaList{})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PKICertificateAuthoritySpec defines the desired state of PKICertificateAuthority
+type PKICertificateAuthoritySpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type pki) holding the CA.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Type is root for a self signed CA generated in Vault, or intermediate
+	// for a CA whose CSR is signed by an external parent.
+	// +kubebuilder:validation:Enum=root;intermediate
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// IssuerName identifies the issuer in the mount, it is used to find an
+	// existing CA instead of generating a new one.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	IssuerName string `json:"issuerName"`
+
+	// +kubebuilder:validation:Required
+	CommonName string `json:"commonName"`
+	// +optional
+	AltNames []string `json:"altNames,omitempty"`
+	// +optional
+	IPSANs []string `json:"ipSans,omitempty"`
+	// +optional
+	Organization []string `json:"organization,omitempty"`
+	// +optional
+	OU []string `json:"ou,omitempty"`
+	// +optional
+	Country []string `json:"country,omitempty"`
+
+	// +kubebuilder:validation:Enum=rsa;ec;ed25519
+	// +kubebuilder:default=rsa
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+	// +optional
+	KeyBits int32 `json:"keyBits,omitempty"`
+
+	// TTL is the validity of the root certificate, e.g. "87600h". Ignored for intermediates.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// SignedCertificateSecretRef selects the PEM certificate signed by the parent
+	// CA, optionally followed by its chain. Only used for intermediates, the CSR
+	// to sign is published in status.csr.
+	// +optional
+	SignedCertificateSecretRef *SecretKeyReference `json:"signedCertificateSecretRef,omitempty"`
+}
+
+// PKICertificateAuthorityStatus defines the observed state of PKICertificateAuthority.
+type PKICertificateAuthorityStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the PKICertificateAuthority resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// +optional
+	IssuerID string `json:"issuerId,omitempty"`
+	// CSR is the pending certificate signing request of an intermediate CA.
+	// +optional
+	CSR string `json:"csr,omitempty"`
+	// Certificate is the PEM encoded CA certificate.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PKICertificateAuthority is the Schema for the pkicertificateauthorities API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type PKICertificateAuthority struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of PKICertificateAuthority
+	// +required
+	Spec PKICertificateAuthoritySpec `json:"spec"`
+
+	// status defines the observed state of PKICertificateAuthority
+	// +optional
+	Status PKICertificateAuthorityStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// PKICertificateAuthorityList contains a list of PKICertificateAuthority
+type PKICertificateAuthorityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []PKICertificateAuthority `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PKICertificateAuthority{}, &PKICertificateAuthorityList{})
+}
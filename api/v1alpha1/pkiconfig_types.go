@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PKIConfigSpec defines the desired state of PKIConfig
+type PKIConfigSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type pki) to configure.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// +optional
+	URLs *PKIURLsConfig `json:"urls,omitempty"`
+
+	// +optional
+	CRL *PKICRLConfig `json:"crl,omitempty"`
+
+	// DefaultIssuer is the name or id of the issuer used when requests do not name one.
+	// +optional
+	DefaultIssuer string `json:"defaultIssuer,omitempty"`
+	// +optional
+	DefaultFollowsLatestIssuer bool `json:"defaultFollowsLatestIssuer,omitempty"`
+}
+
+// PKIURLsConfig holds the URLs encoded in issued certificates.
+type PKIURLsConfig struct {
+	// +optional
+	IssuingCertificates []string `json:"issuingCertificates,omitempty"`
+	// +optional
+	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
+	// +optional
+	OCSPServers []string `json:"ocspServers,omitempty"`
+}
+
+// PKICRLConfig holds the CRL settings of the mount.
+type PKICRLConfig struct {
+	// Expiry is the validity of generated CRLs, e.g. "72h".
+	// +optional
+	Expiry string `json:"expiry,omitempty"`
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+	// +optional
+	AutoRebuild bool `json:"autoRebuild,omitempty"`
+	// +optional
+	AutoRebuildGracePeriod string `json:"autoRebuildGracePeriod,omitempty"`
+	// +optional
+	EnableDelta bool `json:"enableDelta,omitempty"`
+	// +optional
+	DeltaRebuildInterval string `json:"deltaRebuildInterval,omitempty"`
+	// +optional
+	OCSPDisable bool `json:"ocspDisable,omitempty"`
+}
+
+// PKIConfigStatus defines the observed state of PKIConfig.
+type PKIConfigStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the PKIConfig resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PKIConfig is the Schema for the pkiconfigs API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type PKIConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of PKIConfig
+	// +required
+	Spec PKIConfigSpec `json:"spec"`
+
+	// status defines the observed state of PKIConfig
+	// +optional
+	Status PKIConfigStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// PKIConfigList contains a list of PKIConfig
+type PKIConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []PKIConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PKIConfig{}, &PKIConfigList{})
+}
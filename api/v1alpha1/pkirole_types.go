@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// PKIRoleSpec defines the desired state of PKIRole
+type PKIRoleSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type pki) holding the role.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Name is the role name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// IssuerRef is the name or id of the issuer signing certificates of this role.
+	// +kubebuilder:default=default
+	// +optional
+	IssuerRef string `json:"issuerRef,omitempty"`
+
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+	// +optional
+	AllowSubdomains bool `json:"allowSubdomains,omitempty"`
+	// +optional
+	AllowBareDomains bool `json:"allowBareDomains,omitempty"`
+	// +optional
+	AllowGlobDomains bool `json:"allowGlobDomains,omitempty"`
+	// +optional
+	AllowAnyName bool `json:"allowAnyName,omitempty"`
+	// +optional
+	AllowLocalhost *bool `json:"allowLocalhost,omitempty"`
+	// +optional
+	AllowIPSANs *bool `json:"allowIpSans,omitempty"`
+	// +optional
+	AllowWildcardCertificates *bool `json:"allowWildcardCertificates,omitempty"`
+	// +optional
+	EnforceHostnames *bool `json:"enforceHostnames,omitempty"`
+
+	// +optional
+	ServerFlag *bool `json:"serverFlag,omitempty"`
+	// +optional
+	ClientFlag *bool `json:"clientFlag,omitempty"`
+	// KeyUsage lists x509 key usages without the KeyUsage prefix, e.g. DigitalSignature.
+	// +optional
+	KeyUsage []string `json:"keyUsage,omitempty"`
+	// ExtKeyUsage lists x509 extended key usages without the ExtKeyUsage prefix, e.g. ServerAuth.
+	// +optional
+	ExtKeyUsage []string `json:"extKeyUsage,omitempty"`
+
+	// +kubebuilder:validation:Enum=rsa;ec;ed25519;any
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+	// +optional
+	KeyBits int32 `json:"keyBits,omitempty"`
+
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+	// +optional
+	MaxTTL string `json:"maxTtl,omitempty"`
+
+	// +optional
+	Organization []string `json:"organization,omitempty"`
+	// +optional
+	OU []string `json:"ou,omitempty"`
+
+	// NoStore skips storing issued certificates in Vault, they cannot be revoked then.
+	// +optional
+	NoStore bool `json:"noStore,omitempty"`
+}
+
+// PKIRoleStatus defines the observed state of PKIRole.
+type PKIRoleStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the PKIRole resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PKIRole is the Schema for the pkiroles API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type PKIRole struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of PKIRole
+	// +required
+	Spec PKIRoleSpec `json:"spec"`
+
+	// status defines the observed state of PKIRole
+	// +optional
+	Status PKIRoleStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// PKIRoleList contains a list of PKIRole
+type PKIRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []PKIRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PKIRole{}, &PKIRoleList{})
+}
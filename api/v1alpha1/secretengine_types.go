@@ -38,6 +38,46 @@ type SecretEngineSpec struct {
 
 	// +kubebuilder:validation:Required
 	Type string `json:"type,omitempty"`
+
+	// Options are passed to the engine, e.g. version: "2" for a kv mount.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// Config holds the mount settings, kept in sync through sys/mounts/<path>/tune.
+	// +optional
+	Config *SecretEngineConfig `json:"config,omitempty"`
+
+	// PathChangePolicy decides how a change of path is applied to the mount
+	// created under the previous path.
+	// +kubebuilder:default=Remount
+	// +optional
+	PathChangePolicy PathChangePolicy `json:"pathChangePolicy,omitempty"`
+}
+
+// SecretEngineConfig holds the mount level settings of a secret engine.
+// TTLs use the Vault duration format, e.g. "768h" or "3600".
+type SecretEngineConfig struct {
+	// +optional
+	Description string `json:"description,omitempty"`
+	// +optional
+	DefaultLeaseTTL string `json:"defaultLeaseTtl,omitempty"`
+	// +optional
+	MaxLeaseTTL string `json:"maxLeaseTtl,omitempty"`
+	// SealWrap enables seal wrapping of the mount. Vault only accepts it when
+	// the mount is created, later changes are ignored.
+	// +optional
+	SealWrap bool `json:"sealWrap,omitempty"`
+	// +optional
+	AuditNonHMACRequestKeys []string `json:"auditNonHmacRequestKeys,omitempty"`
+	// +optional
+	AuditNonHMACResponseKeys []string `json:"auditNonHmacResponseKeys,omitempty"`
+	// +kubebuilder:validation:Enum=unauth;hidden
+	// +optional
+	ListingVisibility string `json:"listingVisibility,omitempty"`
+	// +optional
+	PassthroughRequestHeaders []string `json:"passthroughRequestHeaders,omitempty"`
+	// +optional
+	AllowedResponseHeaders []string `json:"allowedResponseHeaders,omitempty"`
 }
 
 // SecretEngineStatus defines the observed state of SecretEngine.
@@ -68,6 +108,21 @@ type SecretEngineStatus struct {
 	Message string `json:"message,omitempty"`
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// MountedPath is the path the engine is currently mounted at by the operator.
+	// +optional
+	MountedPath string `json:"mountedPath,omitempty"`
+	// MigrationID is the id of the last remount started by the operator, kept
+	// until the migration finishes.
+	// +optional
+	MigrationID string `json:"migrationId,omitempty"`
+	// MigrationStatus is the last state reported for the remount: in-progress, success or failure.
+	// +optional
+	MigrationStatus string `json:"migrationStatus,omitempty"`
+	// MigrationGeneration is the generation of the spec the last remount was
+	// started for. A failed remount is not retried until the spec changes.
+	// +optional
+	MigrationGeneration int64 `json:"migrationGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,140 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// SSHCASpec defines the desired state of SSHCA
+type SSHCASpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type ssh) holding the CA.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// KeyType and KeyBits apply when Vault generates the signing key, which
+	// happens unless KeyPairSecretRef is set. A generated key is never replaced.
+	// +kubebuilder:validation:Enum=ssh-rsa;ecdsa-sha2-nistp256;ecdsa-sha2-nistp384;ecdsa-sha2-nistp521;ssh-ed25519
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+	// +optional
+	KeyBits int32 `json:"keyBits,omitempty"`
+
+	// KeyPairSecretRef imports an existing signing key pair. The key in Vault
+	// is replaced whenever the public key of the Secret changes.
+	// +optional
+	KeyPairSecretRef *SSHKeyPairSecretReference `json:"keyPairSecretRef,omitempty"`
+
+	// ConfigMapName is the ConfigMap the public key is published to, e.g. to be
+	// mounted as sshd TrustedUserCAKeys. Defaults to the name of the SSHCA.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// +kubebuilder:default=trusted-user-ca-keys.pem
+	// +optional
+	ConfigMapKey string `json:"configMapKey,omitempty"`
+}
+
+// SSHKeyPairSecretReference selects the private and public key of a
+// Kubernetes Secret in the same namespace.
+type SSHKeyPairSecretReference struct {
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// +kubebuilder:default=ssh-privatekey
+	// +optional
+	PrivateKeyKey string `json:"privateKeyKey,omitempty"`
+	// +kubebuilder:default=ssh-publickey
+	// +optional
+	PublicKeyKey string `json:"publicKeyKey,omitempty"`
+}
+
+// SSHCAStatus defines the observed state of SSHCA.
+type SSHCAStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the SSHCA resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// PublicKey is the public key of the CA.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SSHCA is the Schema for the sshcas API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type SSHCA struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of SSHCA
+	// +required
+	Spec SSHCASpec `json:"spec"`
+
+	// status defines the observed state of SSHCA
+	// +optional
+	Status SSHCAStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSHCAList contains a list of SSHCA
+type SSHCAList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []SSHCA `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SSHCA{}, &SSHCAList{})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// SSHRoleSpec defines the desired state of SSHRole
+type SSHRoleSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type ssh) holding the role.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Name is the role name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// KeyType is ca to sign certificates or otp for one time passwords.
+	// +kubebuilder:validation:Enum=ca;otp
+	// +kubebuilder:default=ca
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+
+	// AllowedUsers lists the users certificates may be issued for, "*" for all.
+	// +optional
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+	// +optional
+	DefaultUser string `json:"defaultUser,omitempty"`
+
+	// +optional
+	AllowUserCertificates bool `json:"allowUserCertificates,omitempty"`
+	// +optional
+	AllowHostCertificates bool `json:"allowHostCertificates,omitempty"`
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+	// +optional
+	AllowSubdomains bool `json:"allowSubdomains,omitempty"`
+	// +optional
+	AllowBareDomains bool `json:"allowBareDomains,omitempty"`
+	// +optional
+	AllowUserKeyIDs bool `json:"allowUserKeyIds,omitempty"`
+	// +optional
+	KeyIDFormat string `json:"keyIdFormat,omitempty"`
+
+	// AllowedExtensions lists the extensions users may request, e.g. permit-pty.
+	// +optional
+	AllowedExtensions []string `json:"allowedExtensions,omitempty"`
+	// DefaultExtensions are added to every certificate.
+	// +optional
+	DefaultExtensions map[string]string `json:"defaultExtensions,omitempty"`
+	// +optional
+	AllowedCriticalOptions []string `json:"allowedCriticalOptions,omitempty"`
+	// +optional
+	DefaultCriticalOptions map[string]string `json:"defaultCriticalOptions,omitempty"`
+
+	// +kubebuilder:validation:Enum=default;ssh-rsa;rsa-sha2-256;rsa-sha2-512
+	// +optional
+	AlgorithmSigner string `json:"algorithmSigner,omitempty"`
+
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+	// +optional
+	MaxTTL string `json:"maxTtl,omitempty"`
+
+	// CIDRList restricts the hosts of otp roles.
+	// +optional
+	CIDRList []string `json:"cidrList,omitempty"`
+	// +optional
+	ExcludeCIDRList []string `json:"excludeCidrList,omitempty"`
+	// Port is the ssh port of otp roles.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+}
+
+// SSHRoleStatus defines the observed state of SSHRole.
+type SSHRoleStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the SSHRole resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SSHRole is the Schema for the sshroles API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type SSHRole struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of SSHRole
+	// +required
+	Spec SSHRoleSpec `json:"spec"`
+
+	// status defines the observed state of SSHRole
+	// +optional
+	Status SSHRoleStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSHRoleList contains a list of SSHRole
+type SSHRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []SSHRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SSHRole{}, &SSHRoleList{})
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TOTPKeySpec defines the desired state of TOTPKey
+type TOTPKeySpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type totp) holding the key.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Name is the key name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Generate lets Vault generate the shared key. Otherwise the key of an
+	// external provider is imported from URLSecretRef or KeySecretRef.
+	// TOTP keys cannot be updated, the key is only written when missing.
+	// +optional
+	Generate bool `json:"generate,omitempty"`
+
+	// Issuer and AccountName are required to generate a key.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+	// +optional
+	AccountName string `json:"accountName,omitempty"`
+
+	// URLSecretRef selects an otpauth:// url of a provider-mode key.
+	// +optional
+	URLSecretRef *SecretKeyReference `json:"urlSecretRef,omitempty"`
+	// KeySecretRef selects the base32 shared key of a provider-mode key.
+	// +optional
+	KeySecretRef *SecretKeyReference `json:"keySecretRef,omitempty"`
+
+	// +kubebuilder:validation:Enum=SHA1;SHA256;SHA512
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// +kubebuilder:validation:Enum=6;8
+	// +optional
+	Digits int32 `json:"digits,omitempty"`
+	// +optional
+	Period string `json:"period,omitempty"`
+	// +optional
+	KeySize int32 `json:"keySize,omitempty"`
+
+	// ExportSecretName is the Secret the barcode (base64 PNG) and url of a
+	// generated key are written to. Vault only returns them on creation, so
+	// the Secret is written once and never refreshed.
+	// +optional
+	ExportSecretName string `json:"exportSecretName,omitempty"`
+	// +kubebuilder:default=200
+	// +optional
+	QRSize int32 `json:"qrSize,omitempty"`
+}
+
+// TOTPKeyStatus defines the observed state of TOTPKey.
+type TOTPKeyStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the TOTPKey resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// Exported is set once the barcode and url were written to the export Secret.
+	// +optional
+	Exported bool `json:"exported,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// TOTPKey is the Schema for the totpkeys API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Exported",type=boolean,JSONPath=".status.exported"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type TOTPKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of TOTPKey
+	// +required
+	Spec TOTPKeySpec `json:"spec"`
+
+	// status defines the observed state of TOTPKey
+	// +optional
+	Status TOTPKeyStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// TOTPKeyList contains a list of TOTPKey
+type TOTPKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []TOTPKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TOTPKey{}, &TOTPKeyList{})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TransitKeyRotateAnnotation triggers a rotation of the key whenever its
+// value changes, e.g. kubectl annotate transitkey app vault.ops.community.dev/rotate="$(date +%s)" --overwrite.
+const TransitKeyRotateAnnotation = "vault.ops.community.dev/rotate"
+
+// TransitKeySpec defines the desired state of TransitKey
+type TransitKeySpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// SecretEngine references the SecretEngine (type transit) holding the key.
+	// +kubebuilder:validation:Required
+	SecretEngine SecretEngineReference `json:"secretEngine"`
+
+	// Name is the key name in Vault.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type is the key type. It cannot be changed once the key exists.
+	// +kubebuilder:validation:Enum=aes128-gcm96;aes256-gcm96;chacha20-poly1305;ed25519;ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-2048;rsa-3072;rsa-4096;hmac
+	// +kubebuilder:default=aes256-gcm96
+	// +optional
+	Type string `json:"type,omitempty"`
+	// KeySize is the size in bytes of hmac keys.
+	// +optional
+	KeySize int32 `json:"keySize,omitempty"`
+	// Derived enables key derivation. Only applied at creation.
+	// +optional
+	Derived bool `json:"derived,omitempty"`
+	// ConvergentEncryption requires Derived. Only applied at creation.
+	// +optional
+	ConvergentEncryption bool `json:"convergentEncryption,omitempty"`
+
+	// Exportable allows the key to be exported. It cannot be disabled again.
+	// +optional
+	Exportable bool `json:"exportable,omitempty"`
+	// AllowPlaintextBackup allows a plaintext backup of the key. It cannot be
+	// disabled again.
+	// +optional
+	AllowPlaintextBackup bool `json:"allowPlaintextBackup,omitempty"`
+	// DeletionAllowed allows the key to be deleted. The key is only deleted
+	// from Vault together with the TransitKey when this is set.
+	// +optional
+	DeletionAllowed bool `json:"deletionAllowed,omitempty"`
+
+	// AutoRotatePeriod rotates the key at this interval, e.g. 720h. Empty or
+	// "0" disables automatic rotation.
+	// +optional
+	AutoRotatePeriod string `json:"autoRotatePeriod,omitempty"`
+
+	// MinDecryptionVersion is the oldest key version allowed to decrypt.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinDecryptionVersion int32 `json:"minDecryptionVersion,omitempty"`
+	// MinEncryptionVersion is the oldest key version allowed to encrypt, 0
+	// means the latest.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinEncryptionVersion int32 `json:"minEncryptionVersion,omitempty"`
+}
+
+// TransitKeyStatus defines the observed state of TransitKey.
+type TransitKeyStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the TransitKey resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// +optional
+	LatestVersion int64 `json:"latestVersion,omitempty"`
+	// +optional
+	MinAvailableVersion int64 `json:"minAvailableVersion,omitempty"`
+	// +optional
+	MinDecryptionVersion int64 `json:"minDecryptionVersion,omitempty"`
+	// +optional
+	MinEncryptionVersion int64 `json:"minEncryptionVersion,omitempty"`
+	// LastRotationRequest is the value of the rotate annotation last acted on.
+	// +optional
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+	// LastRotationTime is when the key was last rotated on request.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// TransitKey is the Schema for the transitkeys API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Version",type=integer,JSONPath=".status.latestVersion"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type TransitKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of TransitKey
+	// +required
+	Spec TransitKeySpec `json:"spec"`
+
+	// status defines the observed state of TransitKey
+	// +optional
+	Status TransitKeyStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitKeyList contains a list of TransitKey
+type TransitKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []TransitKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TransitKey{}, &TransitKeyList{})
+}
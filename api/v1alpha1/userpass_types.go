@@ -37,12 +37,49 @@ type UserPassSpec struct {
 	MountPath string `json:"mountPath"`
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
-	// +kubebuilder:default="change_me"
+	// Password is the plaintext password of the user.
+	//
+	// Deprecated: use passwordSecretRef or generatePassword. It is still
+	// honoured when neither of them is set.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// PasswordSecretRef reads the password from a key of a Secret. At most one
+	// of passwordSecretRef and generatePassword may be set.
 	// +optional
-	Password string `json:"password"`
+	PasswordSecretRef *SecretKeyReference `json:"passwordSecretRef,omitempty"`
+	// GeneratePassword lets the operator generate the password and store it
+	// in a Secret owned by the UserPass.
+	// +optional
+	GeneratePassword *GeneratedPassword `json:"generatePassword,omitempty"`
+	// Policies are the token policies of the user, kept in sync on every reconcile.
 	// +kubebuilder:default:={"default"}
 	// +optional
 	Policies []string `json:"policies"`
+
+	// TokenSettings configures the tokens issued on login. Settings removed
+	// from the spec are reset to the Vault defaults.
+	// +optional
+	TokenSettings *TokenSettings `json:"tokenSettings,omitempty"`
+}
+
+// GeneratedPassword configures a password generated by the operator.
+type GeneratedPassword struct {
+	// Length of a random alphanumeric password. Ignored when Policy is set.
+	// +kubebuilder:default=24
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	Length int `json:"length,omitempty"`
+	// Policy is the name of a Vault password policy generating the password.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+	// SecretName is the Secret receiving the username and password keys.
+	// Defaults to the name of the UserPass.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// RotationPeriod regenerates the password once it is older than the period.
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
 }
 
 // UserPassStatus defines the observed state of UserPass.
@@ -72,6 +109,14 @@ type UserPassStatus struct {
 	Message string `json:"message,omitempty"`
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// PasswordHash is a salted hash of the password last written to Vault,
+	// used to detect a changed password source. The plaintext is never stored.
+	// +optional
+	PasswordHash string `json:"passwordHash,omitempty"`
+	// LastPasswordRotation is when the generated password was last regenerated.
+	// +optional
+	LastPasswordRotation *metav1.Time `json:"lastPasswordRotation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
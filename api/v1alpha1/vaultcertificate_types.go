@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// VaultCertificateSpec defines the desired state of VaultCertificate
+type VaultCertificateSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// The following markers will use OpenAPI v3 schema to validate the value
+	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+
+	// PKIRole references the PKIRole the certificate is issued from.
+	// +kubebuilder:validation:Required
+	PKIRole PKIRoleReference `json:"pkiRole"`
+
+	// CommonName is the requested CN of the certificate.
+	// +kubebuilder:validation:Required
+	CommonName string `json:"commonName"`
+	// AltNames are the requested DNS or email subject alternative names.
+	// +optional
+	AltNames []string `json:"altNames,omitempty"`
+	// +optional
+	IPSANs []string `json:"ipSans,omitempty"`
+	// +optional
+	URISANs []string `json:"uriSans,omitempty"`
+
+	// TTL is the requested lifetime, capped by the role max TTL.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// SecretName is the kubernetes.io/tls Secret receiving the certificate.
+	// Defaults to the name of the VaultCertificate.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// RenewAtPercent is the share of the certificate lifetime, in percent,
+	// after which a new certificate is issued.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=66
+	// +optional
+	RenewAtPercent int32 `json:"renewAtPercent,omitempty"`
+}
+
+// VaultCertificateStatus defines the observed state of VaultCertificate.
+type VaultCertificateStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// For Kubernetes API conventions, see:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+
+	// conditions represent the current state of the VaultCertificate resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Standard condition types include:
+	// - "Available": the resource is fully functional
+	// - "Progressing": the resource is being created or updated
+	// - "Degraded": the resource failed to reach or maintain its desired state
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	Synchronized string `json:"synchronized,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// RenewalTime is when the next certificate will be issued.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+	// IssuedGeneration is the generation of the spec the current certificate
+	// was issued for.
+	// +optional
+	IssuedGeneration int64 `json:"issuedGeneration,omitempty"`
+	// IssuedSpecHash is the hash of the spec fields the current certificate
+	// was issued with: PKI role, names and TTL. Changing other fields, like
+	// renewAtPercent, does not issue a new certificate.
+	// +optional
+	IssuedSpecHash string `json:"issuedSpecHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VaultCertificate is the Schema for the vaultcertificates API
+// +kubebuilder:printcolumn:name="Synchronized",type=string,JSONPath=".status.synchronized",description="Current Status"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=".status.message",description="Status message"
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=".status.notAfter"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=".status.lastUpdateTime"
+type VaultCertificate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of VaultCertificate
+	// +required
+	Spec VaultCertificateSpec `json:"spec"`
+
+	// status defines the observed state of VaultCertificate
+	// +optional
+	Status VaultCertificateStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultCertificateList contains a list of VaultCertificate
+type VaultCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []VaultCertificate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultCertificate{}, &VaultCertificateList{})
+}
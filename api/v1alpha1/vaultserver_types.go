@@ -58,6 +58,33 @@ type VaultServerConfig struct {
 	// Namespace is the Kubernetes namespace where the Vault server runs.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// TLS switches the connection to HTTPS and configures how the server
+	// certificate is verified.
+	// +optional
+	TLS *VaultServerTLS `json:"tls,omitempty"`
+
+	// VaultNamespace is the Vault Enterprise namespace every request is sent to.
+	// +optional
+	VaultNamespace string `json:"vaultNamespace,omitempty"`
+}
+
+// VaultServerTLS configures HTTPS towards the Vault server.
+type VaultServerTLS struct {
+	// CASecretRef references the Secret key holding the PEM CA bundle that
+	// signed the server certificate. The Secret lives in the namespace of the
+	// VaultServer. Defaults to the system roots.
+	// +optional
+	CASecretRef *SecretKeyReference `json:"caSecretRef,omitempty"`
+
+	// ServerName is the host name verified in the server certificate, when it
+	// differs from the service name.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // VaultServerStatus defines the observed state of VaultServer.
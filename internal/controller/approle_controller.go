@@ -19,6 +19,8 @@ package controller
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"time"
 
@@ -28,9 +30,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
 	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
@@ -51,6 +55,7 @@ type AppRoleReconciler struct {
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=approles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=approles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=approles/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -79,13 +84,13 @@ func (r *AppRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	}
 
 	vaultOpInstance, err := getVaultOpClient(ctx, appRole.Spec.VaultServer.Name, appRole.Spec.VaultServer.Namespace,
-		req.Namespace, r.Client)
+		appRole, r.Client)
 	if err != nil {
-		return r.updateStatus(ctx, appRole, false,
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
 	}
 
-	appOp := cvault.NewAppRoleOperator(vaultOpInstance.Client, vaultOpInstance.Endpoint)
+	appOp := cvault.NewAppRoleOperator(vaultOpInstance.Client)
 
 	if !appRole.ObjectMeta.DeletionTimestamp.IsZero() {
 		return r.handleDeletion(ctx, appRole, appOp, vaultOpInstance.Token)
@@ -96,41 +101,350 @@ func (r *AppRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		patch := client.MergeFrom(appRole.DeepCopy())
 		controllerutil.AddFinalizer(appRole, appRoleFinalizer)
 		if err := r.Patch(ctx, appRole, patch); err != nil {
-			return r.updateStatus(ctx, appRole, false,
+			return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
 				fmt.Sprintf("Failed to add finalizer to AppRole: %v", err), errorRequeueTime)
 		}
-		// Return immediately after adding finalizer to avoid conflicts
-		return ctrl.Result{RequeueAfter: 0}, nil
+		// Return immediately after adding finalizer to avoid conflicts. The
+		// patch does not change the generation, so requeue explicitly.
+		return ctrl.Result{Requeue: true}, nil
 	}
 
-	err = appOp.CreateorUpdateAppRole(ctx, appRole.Spec.MountPath, appRole.Spec.Name,
-		appRole.Spec.SecretIDTTL, appRole.Spec.Policies, vaultOpInstance.Token)
+	desired, err := appRoleConfig(appRole.Spec)
 	if err != nil {
-		return r.updateStatus(ctx, appRole, false,
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	changed, err := appOp.EnsureAppRole(ctx, appRole.Spec.MountPath, appRole.Spec.Name, desired, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to create or update AppRole in Vault: %v", err), errorRequeueTime)
 	}
 
-	if appRole.Spec.Export != nil && appRole.Spec.Export.Namespace != "" {
-		roleId, err := appOp.GetRoleId(ctx, appRole.Spec.Name, appRole.Spec.MountPath, vaultOpInstance.Token)
+	reason, message := v1alpha1.ReasonSynchronized, "AppRole successfully synchronized"
+	if changed && readyAtGeneration(appRole.Status.Conditions, appRole.Generation) {
+		logger.Info("AppRole drifted from spec and was rewritten", "role", appRole.Spec.Name)
+		appRole.Status.LastDriftCorrection = &metav1.Time{Time: time.Now()}
+		reason, message = v1alpha1.ReasonDriftCorrected, "AppRole drifted and was corrected"
+	}
+
+	targets, err := appRoleExports(appRole)
+	if err != nil {
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	if err := r.syncExports(ctx, appRole, appOp, targets, vaultOpInstance.Token); err != nil {
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to sync AppRole exports: %v", err), errorRequeueTime)
+	}
+
+	if err := r.destroyRetiredSecretIDs(ctx, appRole, appOp, vaultOpInstance.Token); err != nil {
+		return r.updateStatus(ctx, appRole, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to destroy retired secret-id: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, appRole, true, reason, message, appRoleRequeue(appRole, time.Now()))
+}
+
+func appRoleConfig(spec v1alpha1.AppRoleSpec) (cvault.AppRoleConfig, error) {
+	config := cvault.AppRoleConfig{
+		RoleID:             spec.RoleID,
+		BindSecretID:       spec.BindSecretID == nil || *spec.BindSecretID,
+		SecretIDTTL:        int64(spec.SecretIDTTL),
+		SecretIDNumUses:    int64(spec.SecretIDNumUses),
+		SecretIDBoundCIDRs: spec.SecretIDBoundCIDRs,
+		TokenPolicies:      spec.Policies,
+	}
+
+	ts := spec.TokenSettings
+	if ts == nil {
+		return config, nil
+	}
+
+	durations := map[*int64]string{
+		&config.TokenTTL:            ts.TTL,
+		&config.TokenMaxTTL:         ts.MaxTTL,
+		&config.TokenExplicitMaxTTL: ts.ExplicitMaxTTL,
+		&config.TokenPeriod:         ts.Period,
+	}
+	for target, value := range durations {
+		seconds, err := cvault.ParseDurationSeconds(value)
 		if err != nil {
-			return r.updateStatus(ctx, appRole, false, fmt.Sprintf("Failed to get AppRole RoleId: %v", err), errorRequeueTime)
+			return config, err
+		}
+		*target = seconds
+	}
+
+	config.TokenBoundCIDRs = ts.BoundCIDRs
+	config.TokenNumUses = int64(ts.NumUses)
+	config.TokenType = ts.Type
+	config.TokenNoDefaultPolicy = ts.NoDefaultPolicy
+
+	return config, nil
+}
+
+// appRoleExports lists the export targets with their defaults applied.
+func appRoleExports(appRole *v1alpha1.AppRole) ([]v1alpha1.Export, error) {
+	exports := slices.Clone(appRole.Spec.Exports)
+	if appRole.Spec.Export != nil && appRole.Spec.Export.Namespace != "" {
+		exports = append(exports, *appRole.Spec.Export)
+	}
+
+	seen := map[string]bool{}
+	for i := range exports {
+		export := &exports[i]
+		if export.Name == "" {
+			export.Name = fmt.Sprintf("approle-%s-secret", appRole.Name)
+		}
+		if export.RoleIDKey == "" {
+			export.RoleIDKey = "role_id"
+		}
+		if export.SecretIDKey == "" {
+			export.SecretIDKey = "secret_id"
+			if export.Mode == v1alpha1.ExportModeWrapped {
+				export.SecretIDKey = "wrapping_token"
+			}
 		}
+		if export.RoleIDKey == export.SecretIDKey {
+			return nil, fmt.Errorf("export %s/%s uses %s for both role_id and secret-id", export.Namespace, export.Name, export.RoleIDKey)
+		}
+
+		key := export.Namespace + "/" + export.Name
+		if seen[key] {
+			return nil, fmt.Errorf("export %s is listed twice", key)
+		}
+		seen[key] = true
+	}
+
+	return exports, nil
+}
 
-		secretId, err := appOp.GenerateAppRoleSecretID(ctx, appRole.Spec.MountPath, appRole.Spec.Name, vaultOpInstance.Token)
+// syncExports keeps every export target supplied with a secret-id and removes
+// the Secrets of targets no longer in the spec. Status is updated in place so
+// progress survives a failure halfway.
+func (r *AppRoleReconciler) syncExports(ctx context.Context, appRole *v1alpha1.AppRole, appOp *cvault.AppRoleOperator, targets []v1alpha1.Export, token string) error {
+	var roleId string
+	if len(targets) > 0 {
+		var err error
+		roleId, err = appOp.GetRoleId(ctx, appRole.Spec.Name, appRole.Spec.MountPath, token)
 		if err != nil {
-			return r.updateStatus(ctx, appRole, false, fmt.Sprintf("Failed to generate AppRole SecretId: %v", err), errorRequeueTime)
+			return fmt.Errorf("failed to get AppRole RoleId: %w", err)
+		}
+	}
+
+	for _, target := range targets {
+		if err := r.ensureExport(ctx, appRole, appOp, target, roleId, token); err != nil {
+			return fmt.Errorf("export %s/%s: %w", target.Namespace, target.Name, err)
+		}
+	}
+
+	var kept []v1alpha1.ExportStatus
+	for i, status := range appRole.Status.Exports {
+		if slices.ContainsFunc(targets, func(target v1alpha1.Export) bool {
+			return target.Namespace == status.Namespace && target.Name == status.Name
+		}) {
+			kept = append(kept, status)
+			continue
+		}
+
+		if err := r.deleteExportedSecret(ctx, appRole, status.Namespace, status.Name); err != nil {
+			appRole.Status.Exports = append(kept, appRole.Status.Exports[i:]...)
+			return err
+		}
+		if status.SecretIDAccessor != "" {
+			retireSecretID(appRole, status.SecretIDAccessor, time.Now().Add(secretIDGracePeriod(appRole)))
+		}
+	}
+	appRole.Status.Exports = kept
+
+	return nil
+}
+
+// exportStatus returns the status entry of target, adding it when missing.
+func exportStatus(appRole *v1alpha1.AppRole, target v1alpha1.Export) *v1alpha1.ExportStatus {
+	for i := range appRole.Status.Exports {
+		status := &appRole.Status.Exports[i]
+		if status.Namespace == target.Namespace && status.Name == target.Name {
+			return status
+		}
+	}
+
+	appRole.Status.Exports = append(appRole.Status.Exports, v1alpha1.ExportStatus{Namespace: target.Namespace, Name: target.Name})
+	return &appRole.Status.Exports[len(appRole.Status.Exports)-1]
+}
+
+// ensureExport issues and exports a new secret-id only when there is none,
+// the current one is gone from Vault or the exported Secret, or its renewal
+// time has passed. A replaced secret-id is retired rather than destroyed so
+// running clients get a grace period to pick up the new one.
+func (r *AppRoleReconciler) ensureExport(ctx context.Context, appRole *v1alpha1.AppRole, appOp *cvault.AppRoleOperator, target v1alpha1.Export, roleId string, token string) error {
+	logger := logf.FromContext(ctx)
+	if err := r.checkExportTarget(ctx, appRole, target); err != nil {
+		return err
+	}
+
+	status := exportStatus(appRole, target)
+	now := time.Now()
+
+	reason, err := r.secretIDRenewalReason(ctx, appRole, appOp, target, status, now, token)
+	if err != nil {
+		return err
+	}
+	if reason == "" {
+		// keep role_id, labels and annotations current without touching the secret-id
+		return r.writeExportedSecret(ctx, appRole, target, roleId, "")
+	}
+
+	logger.Info("Issuing AppRole secret-id", "export", target.Namespace+"/"+target.Name, "reason", reason)
+	wrapTTL := time.Duration(0)
+	if target.Mode == v1alpha1.ExportModeWrapped {
+		wrapTTL = time.Hour
+		if target.WrapTTL != nil {
+			wrapTTL = target.WrapTTL.Duration
+		}
+	}
+
+	secretId, err := appOp.GenerateAppRoleSecretID(ctx, appRole.Spec.MountPath, appRole.Spec.Name, wrapTTL, token)
+	if err != nil {
+		return fmt.Errorf("failed to generate AppRole secret-id: %w", err)
+	}
+
+	value := secretId.SecretID
+	if wrapTTL > 0 {
+		value = secretId.WrappingToken
+	}
+
+	err = r.writeExportedSecret(ctx, appRole, target, roleId, value)
+	if err != nil {
+		// nobody received the new secret-id, destroy it on the next pass
+		retireSecretID(appRole, secretId.Accessor, now)
+		return err
+	}
+
+	if status.SecretIDAccessor != "" {
+		grace := secretIDGracePeriod(appRole)
+		if reason == reasonWrappingTokenExpired {
+			// the secret-id was never delivered, no client depends on it
+			grace = 0
 		}
+		retireSecretID(appRole, status.SecretIDAccessor, now.Add(grace))
+	}
+
+	status.SecretIDAccessor = secretId.Accessor
+	status.SecretIDRenewalTime = nil
+	if secretId.TTL > 0 {
+		expiry := now.Add(time.Duration(secretId.TTL) * time.Second)
+		status.SecretIDRenewalTime = &metav1.Time{Time: renewalTime(now, expiry, appRole.Spec.SecretIDRenewAtPercent)}
+	}
+	status.SecretIDUnwrapped = false
+	status.WrappingTokenExpiration = nil
+	if wrapTTL > 0 {
+		status.WrappingTokenExpiration = &metav1.Time{Time: secretId.WrappingExpiration}
+	}
 
-		secretName := fmt.Sprintf("approle-%s-secret", appRole.Name)
-		err = r.exportAppRoleSecret(ctx, secretName, roleId, secretId, appRole.Spec.Export.Namespace)
+	return nil
+}
+
+// secretIDRenewalReason tells why a new secret-id is needed, or returns an
+// empty string when the exported one is still good.
+func (r *AppRoleReconciler) secretIDRenewalReason(ctx context.Context, appRole *v1alpha1.AppRole, appOp *cvault.AppRoleOperator, target v1alpha1.Export, status *v1alpha1.ExportStatus, now time.Time, token string) (string, error) {
+	if status.SecretIDAccessor == "" {
+		return "no secret-id issued", nil
+	}
+
+	exported := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: target.Namespace}, exported)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get exported AppRole secret: %w", err)
+	}
+	if errors.IsNotFound(err) || len(exported.Data[target.SecretIDKey]) == 0 {
+		return "exported secret missing", nil
+	}
+
+	if target.Mode == v1alpha1.ExportModeWrapped && !status.SecretIDUnwrapped {
+		valid, err := appOp.LookupWrappingToken(ctx, string(exported.Data[target.SecretIDKey]), token)
 		if err != nil {
-			return r.updateStatus(ctx, appRole, false,
-				fmt.Sprintf("Failed to export AppRole secret: %v", err), errorRequeueTime)
+			return "", fmt.Errorf("failed to look up wrapping token: %w", err)
+		}
+		if !valid {
+			expiration := status.WrappingTokenExpiration
+			if expiration == nil || !now.Before(expiration.Time) {
+				return reasonWrappingTokenExpired, nil
+			}
+			status.SecretIDUnwrapped = true
+		}
+	}
+
+	info, err := appOp.LookupSecretIDAccessor(ctx, appRole.Spec.MountPath, appRole.Spec.Name, status.SecretIDAccessor, token)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up AppRole secret-id: %w", err)
+	}
+	if info == nil {
+		return "secret-id expired or destroyed", nil
+	}
+
+	if status.SecretIDRenewalTime != nil && !now.Before(status.SecretIDRenewalTime.Time) {
+		return "secret-id nearing expiry", nil
+	}
+
+	return "", nil
+}
+
+// reasonWrappingTokenExpired marks a wrapping token that expired before
+// anybody unwrapped it.
+const reasonWrappingTokenExpired = "wrapping token expired unused"
+
+func secretIDGracePeriod(appRole *v1alpha1.AppRole) time.Duration {
+	if appRole.Spec.SecretIDGracePeriod != nil {
+		return appRole.Spec.SecretIDGracePeriod.Duration
+	}
+	return 10 * time.Minute
+}
+
+func retireSecretID(appRole *v1alpha1.AppRole, accessor string, destroyAfter time.Time) {
+	appRole.Status.RetiredSecretIDs = append(appRole.Status.RetiredSecretIDs, v1alpha1.RetiredSecretID{
+		Accessor:     accessor,
+		DestroyAfter: metav1.Time{Time: destroyAfter},
+	})
+}
+
+// destroyRetiredSecretIDs destroys retired secret-ids whose grace period is over.
+func (r *AppRoleReconciler) destroyRetiredSecretIDs(ctx context.Context, appRole *v1alpha1.AppRole, appOp *cvault.AppRoleOperator, token string) error {
+	now := time.Now()
+	remaining := appRole.Status.RetiredSecretIDs[:0]
+	var destroyErr error
+
+	for _, retired := range appRole.Status.RetiredSecretIDs {
+		if destroyErr == nil && !now.Before(retired.DestroyAfter.Time) {
+			destroyErr = appOp.DestroySecretIDAccessor(ctx, appRole.Spec.MountPath, appRole.Spec.Name, retired.Accessor, token)
+			if destroyErr == nil {
+				continue
+			}
 		}
+		remaining = append(remaining, retired)
 	}
 
-	return r.updateStatus(ctx, appRole, true,
-		"AppRole successfully synchronized", 0)
+	appRole.Status.RetiredSecretIDs = remaining
+	return destroyErr
+}
+
+// appRoleRequeue requeues at the next secret-id renewal or destruction, or
+// when a pending wrapping token expires, but at least every defaultRequeueTime.
+func appRoleRequeue(appRole *v1alpha1.AppRole, now time.Time) time.Duration {
+	requeue := defaultRequeueTime
+	for _, status := range appRole.Status.Exports {
+		if status.SecretIDRenewalTime != nil {
+			requeue = min(requeue, status.SecretIDRenewalTime.Sub(now))
+		}
+		if status.WrappingTokenExpiration != nil && !status.SecretIDUnwrapped {
+			requeue = min(requeue, status.WrappingTokenExpiration.Sub(now))
+		}
+	}
+	for _, retired := range appRole.Status.RetiredSecretIDs {
+		requeue = min(requeue, retired.DestroyAfter.Sub(now))
+	}
+	return max(requeue, time.Second)
 }
 
 func (r *AppRoleReconciler) handleDeletion(ctx context.Context, appRole *v1alpha1.AppRole, appOp *cvault.AppRoleOperator, token string) (ctrl.Result, error) {
@@ -143,6 +457,18 @@ func (r *AppRoleReconciler) handleDeletion(ctx context.Context, appRole *v1alpha
 			return ctrl.Result{RequeueAfter: errorRequeueTime}, fmt.Errorf("failed to delete AppRole from Vault: %v", err)
 		}
 
+		// exports from the spec are included for Secrets written before their
+		// status was recorded
+		targets, _ := appRoleExports(appRole)
+		for _, status := range appRole.Status.Exports {
+			targets = append(targets, v1alpha1.Export{Namespace: status.Namespace, Name: status.Name})
+		}
+		for _, target := range targets {
+			if err := r.deleteExportedSecret(ctx, appRole, target.Namespace, target.Name); err != nil {
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
+		}
+
 		patch := client.MergeFrom(appRole.DeepCopy())
 		controllerutil.RemoveFinalizer(appRole, appRoleFinalizer)
 		if err := r.Patch(ctx, appRole, patch); err != nil {
@@ -153,50 +479,102 @@ func (r *AppRoleReconciler) handleDeletion(ctx context.Context, appRole *v1alpha
 	return ctrl.Result{}, nil
 }
 
-func (r *AppRoleReconciler) exportAppRoleSecret(ctx context.Context, secretName, roleId, secretId, namespace string) error {
+// writeExportedSecret writes role_id, labels and annotations of the target.
+// With an empty value the exported secret-id is kept, otherwise it is
+// replaced. Keys not belonging to the target are dropped, while labels and
+// annotations set by others are kept. Secrets not exported by this AppRole
+// are never taken over.
+func (r *AppRoleReconciler) writeExportedSecret(ctx context.Context, appRole *v1alpha1.AppRole, target v1alpha1.Export, roleId string, value string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      target.Name,
+			Namespace: target.Namespace,
+		},
+	}
 
-	secretObj := constructAppRoleSecretObject(secretName, namespace, roleId, secretId)
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.ResourceVersion != "" && !exportedBy(secret, appRole) {
+			return errSecretNotExported(secret)
+		}
+
+		if value == "" {
+			value = string(secret.Data[target.SecretIDKey])
+		}
+		secret.Data = map[string][]byte{
+			target.RoleIDKey:   []byte(roleId),
+			target.SecretIDKey: []byte(value),
+		}
+
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		maps.Copy(secret.Labels, target.Labels)
+		secret.Labels[v1alpha1.AppRoleNameLabel] = appRole.Name
+		secret.Labels[v1alpha1.AppRoleNamespaceLabel] = appRole.Namespace
+
+		if len(target.Annotations) > 0 {
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			maps.Copy(secret.Annotations, target.Annotations)
+		}
 
-	err := createOrUpdateK8sSecret(ctx, r.Client, secretObj)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create or update exported AppRole secret: %v", err)
+		return fmt.Errorf("failed to create or update exported AppRole secret: %w", err)
 	}
 
 	return nil
 }
 
-func constructAppRoleSecretObject(name, namespace string, roleId, secretId string) *corev1.Secret {
-	data := make(map[string][]byte)
-	data["role_id"] = []byte(roleId)
-	data["secret_id"] = []byte(secretId)
+// checkExportTarget fails when the target Secret exists but was not exported
+// by this AppRole, before a secret-id is issued for it.
+func (r *AppRoleReconciler) checkExportTarget(ctx context.Context, appRole *v1alpha1.AppRole, target v1alpha1.Export) error {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: target.Namespace}, secret)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
 
-	return &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-		Data: data,
+	if !exportedBy(secret, appRole) {
+		return errSecretNotExported(secret)
 	}
+	return nil
+}
+
+// exportedBy tells whether secret carries the labels of an export of appRole.
+func exportedBy(secret *corev1.Secret, appRole *v1alpha1.AppRole) bool {
+	return secret.Labels[v1alpha1.AppRoleNameLabel] == appRole.Name &&
+		secret.Labels[v1alpha1.AppRoleNamespaceLabel] == appRole.Namespace
+}
+
+func errSecretNotExported(secret *corev1.Secret) error {
+	return fmt.Errorf("secret %s/%s already exists and is not exported by this AppRole", secret.Namespace, secret.Name)
 }
 
-func createOrUpdateK8sSecret(ctx context.Context, k8sClient client.Client, secret *corev1.Secret) error {
-	existingSecret := &corev1.Secret{}
-	err := k8sClient.Get(ctx, client.ObjectKey{Name: secret.Name, Namespace: secret.Namespace}, existingSecret)
+// deleteExportedSecret deletes an exported Secret, leaving alone Secrets not
+// labeled as exported by this AppRole.
+func (r *AppRoleReconciler) deleteExportedSecret(ctx context.Context, appRole *v1alpha1.AppRole, namespace string, name string) error {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, secret)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			// Create the secret
-			return k8sClient.Create(ctx, secret)
-		}
-		return err
+		return client.IgnoreNotFound(err)
 	}
 
-	// Update the existing secret
-	existingSecret.Data = secret.Data
-	return k8sClient.Update(ctx, existingSecret)
+	if !exportedBy(secret, appRole) {
+		logf.FromContext(ctx).Info("Leaving Secret not exported by this AppRole", "secret", namespace+"/"+name)
+		return nil
+	}
+
+	if err := r.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete exported AppRole secret %s/%s: %w", namespace, name, err)
+	}
+	return nil
 }
 
 func (r *AppRoleReconciler) updateStatus(ctx context.Context, appRole *v1alpha1.AppRole,
-	synced bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	synced bool, reason string, message string, requeueAfter time.Duration) (ctrl.Result, error) {
 
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		latest := &v1alpha1.AppRole{}
@@ -207,6 +585,12 @@ func (r *AppRoleReconciler) updateStatus(ctx context.Context, appRole *v1alpha1.
 		latest.Status.Synchronized = strconv.FormatBool(synced)
 		latest.Status.Message = message
 		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.Exports = appRole.Status.Exports
+		latest.Status.RetiredSecretIDs = appRole.Status.RetiredSecretIDs
+		if appRole.Status.LastDriftCorrection != nil {
+			latest.Status.LastDriftCorrection = appRole.Status.LastDriftCorrection
+		}
+		setReadyCondition(&latest.Status.Conditions, appRole.Generation, synced, reason, message)
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -219,10 +603,12 @@ func (r *AppRoleReconciler) updateStatus(ctx context.Context, appRole *v1alpha1.
 
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager. Only spec changes
+// trigger a reconcile: status writes would otherwise loop, and secret-id
+// renewals are driven by appRoleRequeue.
 func (r *AppRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&vaultv1alpha1.AppRole{}).
+		For(&vaultv1alpha1.AppRole{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("approle").
 		Complete(r)
 }
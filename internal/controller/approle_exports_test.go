@@ -0,0 +1,261 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+func exportedSecret(name string, labels map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Data:       map[string][]byte{"role_id": []byte("role"), "secret_id": []byte("secret")},
+	}
+}
+
+func appRoleLabels() map[string]string {
+	return map[string]string{
+		v1alpha1.AppRoleNameLabel:      "app",
+		v1alpha1.AppRoleNamespaceLabel: "default",
+	}
+}
+
+func TestAppRoleExports(t *testing.T) {
+	testCases := []struct {
+		name    string
+		export  *v1alpha1.Export
+		exports []v1alpha1.Export
+		want    []v1alpha1.Export
+		wantErr bool
+	}{
+		{
+			name:    "legacy export merged with exports",
+			export:  &v1alpha1.Export{Namespace: "legacy", Name: "creds"},
+			exports: []v1alpha1.Export{{Namespace: "apps", Name: "creds"}},
+			want: []v1alpha1.Export{
+				{Namespace: "apps", Name: "creds", RoleIDKey: "role_id", SecretIDKey: "secret_id"},
+				{Namespace: "legacy", Name: "creds", RoleIDKey: "role_id", SecretIDKey: "secret_id"},
+			},
+		},
+		{
+			name:   "legacy export without namespace ignored",
+			export: &v1alpha1.Export{Name: "creds"},
+		},
+		{
+			name: "default name and keys",
+			exports: []v1alpha1.Export{
+				{Namespace: "apps"},
+				{Namespace: "wrapped", Mode: v1alpha1.ExportModeWrapped},
+				{Namespace: "custom", Name: "creds", RoleIDKey: "id", SecretIDKey: "secret"},
+			},
+			want: []v1alpha1.Export{
+				{Namespace: "apps", Name: "approle-app-secret", RoleIDKey: "role_id", SecretIDKey: "secret_id"},
+				{Namespace: "wrapped", Name: "approle-app-secret", RoleIDKey: "role_id", SecretIDKey: "wrapping_token", Mode: v1alpha1.ExportModeWrapped},
+				{Namespace: "custom", Name: "creds", RoleIDKey: "id", SecretIDKey: "secret"},
+			},
+		},
+		{
+			name:    "target listed twice",
+			export:  &v1alpha1.Export{Namespace: "apps"},
+			exports: []v1alpha1.Export{{Namespace: "apps"}},
+			wantErr: true,
+		},
+		{
+			name:    "same key for role_id and secret-id",
+			exports: []v1alpha1.Export{{Namespace: "apps", RoleIDKey: "creds", SecretIDKey: "creds"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appRole := testAppRole()
+			appRole.Spec.Export = tc.export
+			appRole.Spec.Exports = tc.exports
+
+			exports, err := appRoleExports(appRole)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, exports)
+		})
+	}
+}
+
+func TestSyncExportsPrunesRemovedTargets(t *testing.T) {
+	appRole := testAppRole()
+	appRole.Status.Exports = []v1alpha1.ExportStatus{{Namespace: "default", Name: "old", SecretIDAccessor: "old-accessor"}}
+
+	c := newFakeClient(t, exportedSecret("old", appRoleLabels()))
+	r := &AppRoleReconciler{Client: c}
+	appOp := cvault.NewAppRoleOperator(&fakeVaultClient{
+		roleID: "role",
+		responses: map[string]*vault.Response[map[string]interface{}]{
+			"auth/approle/role/app/secret-id": {Data: map[string]interface{}{
+				"secret_id":          "new-secret",
+				"secret_id_accessor": "new-accessor",
+				"secret_id_ttl":      json.Number("0"),
+			}},
+		},
+	})
+
+	targets := []v1alpha1.Export{{Namespace: "default", Name: "new", RoleIDKey: "role_id", SecretIDKey: "secret_id"}}
+	require.NoError(t, r.syncExports(context.Background(), appRole, appOp, targets, "token"))
+
+	err := c.Get(context.Background(), client.ObjectKey{Name: "old", Namespace: "default"}, &corev1.Secret{})
+	assert.True(t, errors.IsNotFound(err), "the Secret of the removed target is deleted")
+
+	created := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "new", Namespace: "default"}, created))
+	assert.Equal(t, "role", string(created.Data["role_id"]))
+	assert.Equal(t, "new-secret", string(created.Data["secret_id"]))
+
+	assert.Equal(t, []v1alpha1.ExportStatus{{Namespace: "default", Name: "new", SecretIDAccessor: "new-accessor"}}, appRole.Status.Exports)
+	require.Len(t, appRole.Status.RetiredSecretIDs, 1)
+	assert.Equal(t, "old-accessor", appRole.Status.RetiredSecretIDs[0].Accessor)
+}
+
+func TestWriteExportedSecret(t *testing.T) {
+	target := v1alpha1.Export{
+		Namespace:   "default",
+		RoleIDKey:   "role_id",
+		SecretIDKey: "secret_id",
+		Labels:      map[string]string{"app": "web"},
+		Annotations: map[string]string{"note": "exported"},
+	}
+
+	t.Run("refuses secrets not exported by the AppRole", func(t *testing.T) {
+		foreign := exportedSecret("foreign", map[string]string{"team": "a"})
+		c := newFakeClient(t, foreign)
+		r := &AppRoleReconciler{Client: c}
+
+		target := target
+		target.Name = "foreign"
+		assert.Error(t, r.checkExportTarget(context.Background(), testAppRole(), target))
+		assert.Error(t, r.writeExportedSecret(context.Background(), testAppRole(), target, "new-role", "new-secret"))
+
+		current := &corev1.Secret{}
+		require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(foreign), current))
+		assert.Equal(t, foreign.Data, current.Data)
+		assert.Equal(t, foreign.Labels, current.Labels)
+	})
+
+	t.Run("merges labels and annotations", func(t *testing.T) {
+		owned := exportedSecret("owned", appRoleLabels())
+		owned.Labels["team"] = "a"
+		owned.Annotations = map[string]string{"backup": "daily"}
+		c := newFakeClient(t, owned)
+		r := &AppRoleReconciler{Client: c}
+
+		target := target
+		target.Name = "owned"
+		require.NoError(t, r.checkExportTarget(context.Background(), testAppRole(), target))
+		require.NoError(t, r.writeExportedSecret(context.Background(), testAppRole(), target, "role", ""))
+
+		current := &corev1.Secret{}
+		require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(owned), current))
+		assert.Equal(t, "a", current.Labels["team"])
+		assert.Equal(t, "web", current.Labels["app"])
+		assert.Equal(t, "app", current.Labels[v1alpha1.AppRoleNameLabel])
+		assert.Equal(t, map[string]string{"backup": "daily", "note": "exported"}, current.Annotations)
+		assert.Equal(t, "secret", string(current.Data["secret_id"]), "an empty value keeps the exported secret-id")
+	})
+}
+
+func TestHandleDeletionLeavesUnlabelledSecrets(t *testing.T) {
+	appRole := testAppRole()
+	appRole.Finalizers = []string{appRoleFinalizer}
+	appRole.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	appRole.Spec.Exports = []v1alpha1.Export{{Namespace: "default", Name: "foreign"}}
+	appRole.Status.Exports = []v1alpha1.ExportStatus{{Namespace: "default", Name: "owned"}}
+
+	c := newFakeClient(t, appRole, exportedSecret("foreign", nil), exportedSecret("owned", appRoleLabels()))
+	r := &AppRoleReconciler{Client: c}
+
+	_, err := r.handleDeletion(context.Background(), appRole, cvault.NewAppRoleOperator(&fakeVaultClient{}), "token")
+	require.NoError(t, err)
+
+	assert.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "foreign", Namespace: "default"}, &corev1.Secret{}))
+	err = c.Get(context.Background(), client.ObjectKey{Name: "owned", Namespace: "default"}, &corev1.Secret{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestAppRoleRequeue(t *testing.T) {
+	now := time.Now()
+	at := func(d time.Duration) *metav1.Time { return &metav1.Time{Time: now.Add(d)} }
+
+	testCases := []struct {
+		name   string
+		status v1alpha1.AppRoleStatus
+		want   time.Duration
+	}{
+		{
+			name: "nothing pending",
+			want: defaultRequeueTime,
+		},
+		{
+			name: "earliest secret-id renewal",
+			status: v1alpha1.AppRoleStatus{Exports: []v1alpha1.ExportStatus{
+				{SecretIDRenewalTime: at(3 * time.Minute)},
+				{SecretIDRenewalTime: at(2 * time.Minute)},
+			}},
+			want: 2 * time.Minute,
+		},
+		{
+			name: "pending wrapping token",
+			status: v1alpha1.AppRoleStatus{Exports: []v1alpha1.ExportStatus{
+				{WrappingTokenExpiration: at(time.Minute)},
+				{WrappingTokenExpiration: at(30 * time.Second), SecretIDUnwrapped: true},
+			}},
+			want: time.Minute,
+		},
+		{
+			name:   "retired secret-id",
+			status: v1alpha1.AppRoleStatus{RetiredSecretIDs: []v1alpha1.RetiredSecretID{{DestroyAfter: *at(90 * time.Second)}}},
+			want:   90 * time.Second,
+		},
+		{
+			name:   "overdue renewal",
+			status: v1alpha1.AppRoleStatus{Exports: []v1alpha1.ExportStatus{{SecretIDRenewalTime: at(-time.Minute)}}},
+			want:   time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appRole := testAppRole()
+			appRole.Status = tc.status
+			assert.Equal(t, tc.want, appRoleRequeue(appRole, now))
+		})
+	}
+}
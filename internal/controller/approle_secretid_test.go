@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+const (
+	testLookupPath  = "auth/approle/role/app/secret-id-accessor/lookup"
+	testDestroyPath = "auth/approle/role/app/secret-id-accessor/destroy"
+)
+
+func testAppRole() *v1alpha1.AppRole {
+	return &v1alpha1.AppRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       v1alpha1.AppRoleSpec{Name: "app", MountPath: "approle"},
+	}
+}
+
+func TestSecretIDRenewalReason(t *testing.T) {
+	now := time.Now()
+	exportedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+		Data:       map[string][]byte{"secret_id": []byte("secret")},
+	}
+	knownAccessor := map[string]*vault.Response[map[string]interface{}]{
+		testLookupPath: {Data: map[string]interface{}{"creation_time": now.Format(time.RFC3339)}},
+	}
+
+	testCases := []struct {
+		name      string
+		target    v1alpha1.Export
+		status    v1alpha1.ExportStatus
+		secret    *corev1.Secret
+		responses map[string]*vault.Response[map[string]interface{}]
+		errors    map[string]error
+		reason    string
+		unwrapped bool
+	}{
+		{
+			name:   "no secret-id issued yet",
+			reason: "no secret-id issued",
+		},
+		{
+			name:   "exported secret deleted",
+			status: v1alpha1.ExportStatus{SecretIDAccessor: "acc"},
+			reason: "exported secret missing",
+		},
+		{
+			name:   "secret-id gone from vault",
+			status: v1alpha1.ExportStatus{SecretIDAccessor: "acc"},
+			secret: exportedSecret,
+			reason: "secret-id expired or destroyed",
+		},
+		{
+			name:      "renewal time passed",
+			status:    v1alpha1.ExportStatus{SecretIDAccessor: "acc", SecretIDRenewalTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+			secret:    exportedSecret,
+			responses: knownAccessor,
+			reason:    "secret-id nearing expiry",
+		},
+		{
+			name:      "secret-id still good",
+			status:    v1alpha1.ExportStatus{SecretIDAccessor: "acc", SecretIDRenewalTime: &metav1.Time{Time: now.Add(time.Hour)}},
+			secret:    exportedSecret,
+			responses: knownAccessor,
+		},
+		{
+			name:   "wrapping token expired unused",
+			target: v1alpha1.Export{Mode: v1alpha1.ExportModeWrapped},
+			status: v1alpha1.ExportStatus{SecretIDAccessor: "acc", WrappingTokenExpiration: &metav1.Time{Time: now.Add(-time.Minute)}},
+			secret: exportedSecret,
+			errors: map[string]error{"sys/wrapping/lookup": &vault.ResponseError{StatusCode: 400}},
+			reason: reasonWrappingTokenExpired,
+		},
+		{
+			name:      "wrapping token unwrapped before expiry",
+			target:    v1alpha1.Export{Mode: v1alpha1.ExportModeWrapped},
+			status:    v1alpha1.ExportStatus{SecretIDAccessor: "acc", WrappingTokenExpiration: &metav1.Time{Time: now.Add(time.Minute)}},
+			secret:    exportedSecret,
+			responses: knownAccessor,
+			errors:    map[string]error{"sys/wrapping/lookup": &vault.ResponseError{StatusCode: 400}},
+			unwrapped: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var objs []client.Object
+			if tc.secret != nil {
+				objs = append(objs, tc.secret.DeepCopy())
+			}
+			r := &AppRoleReconciler{Client: newFakeClient(t, objs...)}
+			appOp := cvault.NewAppRoleOperator(&fakeVaultClient{responses: tc.responses, errors: tc.errors})
+
+			target := tc.target
+			target.Name, target.Namespace, target.SecretIDKey = "creds", "default", "secret_id"
+			status := tc.status
+
+			reason, err := r.secretIDRenewalReason(context.Background(), testAppRole(), appOp, target, &status, now, "token")
+			require.NoError(t, err)
+			assert.Equal(t, tc.reason, reason)
+			assert.Equal(t, tc.unwrapped, status.SecretIDUnwrapped)
+		})
+	}
+}
+
+func TestDestroyRetiredSecretIDs(t *testing.T) {
+	now := time.Now()
+	appRole := testAppRole()
+	appRole.Status.RetiredSecretIDs = []v1alpha1.RetiredSecretID{
+		{Accessor: "due", DestroyAfter: metav1.Time{Time: now.Add(-time.Minute)}},
+		{Accessor: "pending", DestroyAfter: metav1.Time{Time: now.Add(time.Hour)}},
+	}
+
+	vc := &fakeVaultClient{}
+	r := &AppRoleReconciler{Client: newFakeClient(t)}
+	err := r.destroyRetiredSecretIDs(context.Background(), appRole, cvault.NewAppRoleOperator(vc), "token")
+	require.NoError(t, err)
+
+	assert.Equal(t, "due", vc.writes[testDestroyPath]["secret_id_accessor"])
+	require.Len(t, appRole.Status.RetiredSecretIDs, 1)
+	assert.Equal(t, "pending", appRole.Status.RetiredSecretIDs[0].Accessor)
+}
+
+func TestDestroyRetiredSecretIDsKeepsFailed(t *testing.T) {
+	now := time.Now()
+	appRole := testAppRole()
+	appRole.Status.RetiredSecretIDs = []v1alpha1.RetiredSecretID{
+		{Accessor: "first", DestroyAfter: metav1.Time{Time: now.Add(-time.Hour)}},
+		{Accessor: "second", DestroyAfter: metav1.Time{Time: now.Add(-time.Minute)}},
+	}
+
+	vc := &fakeVaultClient{errors: map[string]error{testDestroyPath: errors.New("vault unavailable")}}
+	r := &AppRoleReconciler{Client: newFakeClient(t)}
+	err := r.destroyRetiredSecretIDs(context.Background(), appRole, cvault.NewAppRoleOperator(vc), "token")
+	assert.Error(t, err)
+
+	// the failed one and those after it are retried on the next pass
+	assert.Len(t, appRole.Status.RetiredSecretIDs, 2)
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	auditDeviceFinalizer = "auditdevice.finalizers.ops.community.dev"
+)
+
+// AuditDeviceReconciler reconciles a AuditDevice object
+type AuditDeviceReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=auditdevices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=auditdevices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=auditdevices/finalizers,verbs=update
+
+// Reconcile enables the audit device and disables it when the object is
+// deleted. Vault cannot update an enabled device, so one whose type or
+// options differ from the spec is disabled and enabled again.
+func (r *AuditDeviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Audit Device Reconciliation")
+
+	obj := &v1alpha1.AuditDevice{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	auditOp := cvault.NewAuditOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, auditOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, auditDeviceFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, auditDeviceFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	devices, err := auditOp.ListDevices(ctx, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to list audit devices: %v", err), errorRequeueTime)
+	}
+
+	path := auditDevicePath(obj.Spec)
+	if previous := obj.Status.Path; previous != "" && previous != path {
+		if _, ok := devices[previous]; ok {
+			if err := auditOp.DisableDevice(ctx, previous, vaultOpInstance.Token); err != nil {
+				return r.updateStatus(ctx, obj, false,
+					fmt.Sprintf("Failed to disable audit device at previous path %s: %v", previous, err), errorRequeueTime)
+			}
+		}
+	}
+	obj.Status.Path = path
+
+	request := auditDeviceRequest(obj.Spec)
+	current, enabled := devices[path]
+	if enabled && auditDeviceMatches(current, request) {
+		return r.updateStatus(ctx, obj, true,
+			"Audit device synchronized successfully", defaultRequeueTime)
+	}
+
+	if enabled {
+		logger.Info("Audit device drifted from spec, re-enabling it", "path", path)
+		if err := auditOp.DisableDevice(ctx, path, vaultOpInstance.Token); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to disable drifted audit device: %v", err), errorRequeueTime)
+		}
+	}
+
+	if err := auditOp.EnableDevice(ctx, path, request, vaultOpInstance.Token); err != nil {
+		obj.Status.Path = ""
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to enable audit device: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Audit device enabled successfully", defaultRequeueTime)
+}
+
+func auditDevicePath(spec v1alpha1.AuditDeviceSpec) string {
+	if spec.Path != "" {
+		return spec.Path
+	}
+	return spec.Type
+}
+
+// auditDeviceRequest maps the spec to the device options. Vault expects all
+// option values as strings.
+func auditDeviceRequest(spec v1alpha1.AuditDeviceSpec) schema.AuditingEnableDeviceRequest {
+	options := map[string]interface{}{}
+	for k, v := range spec.Options {
+		options[k] = v
+	}
+
+	typed := map[string]string{
+		"file_path":   spec.FilePath,
+		"mode":        spec.Mode,
+		"address":     spec.Address,
+		"socket_type": spec.SocketType,
+		"facility":    spec.Facility,
+		"tag":         spec.Tag,
+		"format":      spec.Format,
+		"prefix":      spec.Prefix,
+	}
+	for k, v := range typed {
+		if v != "" {
+			options[k] = v
+		}
+	}
+
+	if spec.LogRaw {
+		options["log_raw"] = "true"
+	}
+	if spec.HMACAccessor != nil {
+		options["hmac_accessor"] = strconv.FormatBool(*spec.HMACAccessor)
+	}
+
+	return schema.AuditingEnableDeviceRequest{
+		Type:        spec.Type,
+		Description: spec.Description,
+		Local:       spec.Local,
+		Options:     options,
+	}
+}
+
+// auditDeviceMatches compares the enabled device with the request. Only the
+// options set in the spec are compared, Vault may report more of them.
+func auditDeviceMatches(current cvault.AuditDevice, request schema.AuditingEnableDeviceRequest) bool {
+	if current.Type != request.Type || current.Description != request.Description || current.Local != request.Local {
+		return false
+	}
+
+	for k, v := range request.Options {
+		if current.Options[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *AuditDeviceReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.AuditDevice, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.AuditDevice{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.Path != "" {
+			latest.Status.Path = obj.Status.Path
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *AuditDeviceReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.AuditDevice, auditOp *cvault.AuditOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, auditDeviceFinalizer) {
+		path := obj.Status.Path
+		if path == "" {
+			path = auditDevicePath(obj.Spec)
+		}
+		err := auditOp.DisableDevice(ctx, path, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, auditDeviceFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AuditDeviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.AuditDevice{}, specChanged).
+		Named("auditdevice").
+		Complete(r)
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -37,6 +38,7 @@ import (
 
 const (
 	authMethodFinalizer = "authmethod.finalizers.ops.community.dev"
+	remountPollTime     = 10 * time.Second
 )
 
 // AuthMethodReconciler reconciles a AuthMethod object
@@ -68,9 +70,9 @@ func (r *AuthMethodReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, req.Namespace, r.Client)
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
 	if err != nil {
-		return r.updateStatus(ctx, obj, false,
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
 	}
 
@@ -83,25 +85,125 @@ func (r *AuthMethodReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		patch := client.MergeFrom(obj.DeepCopy())
 		controllerutil.AddFinalizer(obj, authMethodFinalizer)
 		if err := r.Patch(ctx, obj, patch); err != nil {
-			return r.updateStatus(ctx, obj, false,
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
 		}
 
 		return ctrl.Result{RequeueAfter: 0}, nil
 	}
 
+	if obj.Status.MountedPath != "" && obj.Status.MountedPath != obj.Spec.Path {
+		return r.movePath(ctx, obj, vaultAuthOp, vaultOpInstance.Token)
+	}
+
 	err = vaultAuthOp.EnableAuthMethod(obj.Spec.Path, obj.Spec.Type, vaultOpInstance.Token)
+	var mismatch *cvault.MountTypeMismatchError
+	if errors.As(err, &mismatch) {
+		// someone else owns this path, never touch it
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonTypeMismatch,
+			fmt.Sprintf("Blocked: %v", err), defaultRequeueTime)
+	}
 	if err != nil {
-		return r.updateStatus(ctx, obj, false,
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to enable auth method: %v", err), errorRequeueTime)
 	}
 
-	return r.updateStatus(ctx, obj, true,
+	obj.Status.MountedPath = obj.Spec.Path
+	return r.updateStatus(ctx, obj, true, v1alpha1.ReasonSynchronized,
 		"Auth method synchronized successfully", defaultRequeueTime)
 
 }
 
-func (r *AuthMethodReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.AuthMethod, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+// movePath applies a change of spec.path to the mount living at
+// status.mountedPath, either by remounting it or, when the policy allows it,
+// by disabling it so the next reconcile enables a fresh mount. As for secret
+// engines, a remount Vault reports as failed is final for the generation it
+// was started for; errors reaching Vault are retried.
+func (r *AuthMethodReconciler) movePath(ctx context.Context, obj *v1alpha1.AuthMethod, vaultAuthOp *cvault.AuthOperator, token string) (ctrl.Result, error) {
+	from, to := obj.Status.MountedPath, obj.Spec.Path
+
+	if obj.Status.MigrationStatus == cvault.MigrationFailure {
+		if obj.Status.MigrationGeneration == obj.Generation {
+			return ctrl.Result{}, nil
+		}
+
+		// the spec changed since the failure, try again
+		obj.Status.MigrationID = ""
+		obj.Status.MigrationStatus = ""
+	}
+
+	if obj.Status.MigrationID != "" {
+		status, err := vaultAuthOp.RemountStatus(ctx, obj.Status.MigrationID, token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+				fmt.Sprintf("Failed to read remount status: %v", err), errorRequeueTime)
+		}
+		obj.Status.MigrationStatus = status
+
+		switch status {
+		case cvault.MigrationSuccess:
+			obj.Status.MountedPath = to
+			obj.Status.MigrationID = ""
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+				fmt.Sprintf("Auth method remounted from %s to %s", from, to), 0)
+		case cvault.MigrationFailure:
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemountFailed,
+				fmt.Sprintf("Remount from %s to %s failed (migration %s), update the spec to retry", from, to, obj.Status.MigrationID), 0)
+		default:
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+				fmt.Sprintf("Remount from %s to %s in progress", from, to), remountPollTime)
+		}
+	}
+
+	_, fromMounted, err := vaultAuthOp.AuthMethodType(from, token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to list auth methods: %v", err), errorRequeueTime)
+	}
+	if !fromMounted {
+		// nothing left at the old path, start over at the new one
+		obj.Status.MountedPath = ""
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+			fmt.Sprintf("Auth method not found at %s, enabling it at %s", from, to), 0)
+	}
+
+	_, toMounted, err := vaultAuthOp.AuthMethodType(to, token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to list auth methods: %v", err), errorRequeueTime)
+	}
+	if toMounted {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonPathConflict,
+			fmt.Sprintf("Blocked: cannot move auth method from %s, path %s is already in use", from, to), defaultRequeueTime)
+	}
+
+	if obj.Spec.PathChangePolicy == v1alpha1.PathChangeDisable {
+		if err := vaultAuthOp.DisableAuthMethod(from, token); err != nil {
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+				fmt.Sprintf("Failed to disable auth method at %s: %v", from, err), errorRequeueTime)
+		}
+
+		obj.Status.MountedPath = ""
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+			fmt.Sprintf("Auth method disabled at %s, enabling it at %s", from, to), 0)
+	}
+
+	migrationID, err := vaultAuthOp.RemountAuthMethod(ctx, from, to, token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to remount auth method from %s to %s: %v", from, to, err), errorRequeueTime)
+	}
+
+	obj.Status.MigrationID = migrationID
+	obj.Status.MigrationStatus = cvault.MigrationInProgress
+	obj.Status.MigrationGeneration = obj.Generation
+	return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+		fmt.Sprintf("Remount from %s to %s started", from, to), remountPollTime)
+}
+
+// updateStatus also persists the mount tracking fields held in obj.Status and
+// mirrors the outcome into the Ready condition.
+func (r *AuthMethodReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.AuthMethod, synchronized bool, reason string, message string, requeueAfter time.Duration) (ctrl.Result, error) {
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		latest := &v1alpha1.AuthMethod{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
@@ -111,6 +213,11 @@ func (r *AuthMethodReconciler) updateStatus(ctx context.Context, obj *vaultv1alp
 		latest.Status.Message = message
 		latest.Status.Synchronized = strconv.FormatBool(synchronized)
 		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.MountedPath = obj.Status.MountedPath
+		latest.Status.MigrationID = obj.Status.MigrationID
+		latest.Status.MigrationStatus = obj.Status.MigrationStatus
+		latest.Status.MigrationGeneration = obj.Status.MigrationGeneration
+		setReadyCondition(&latest.Status.Conditions, latest.Generation, synchronized, reason, message)
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -129,10 +236,24 @@ func (r *AuthMethodReconciler) updateStatus(ctx context.Context, obj *vaultv1alp
 func (r *AuthMethodReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.AuthMethod, vaultAuthOp *cvault.AuthOperator, token string) (ctrl.Result, error) {
 	if controllerutil.ContainsFinalizer(obj, authMethodFinalizer) {
 		// Our finalizer is present, so lets handle any external dependency
-		err := vaultAuthOp.DisableAuthMethod(obj.Spec.Path, token)
-		if err != nil {
-			// requeue on error for proper cleaning
-			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		path := obj.Status.MountedPath
+		if path == "" {
+			// never mounted by us, only clean up if it is not someone else's mount
+			mType, ok, err := vaultAuthOp.AuthMethodType(obj.Spec.Path, token)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
+			if ok && mType == obj.Spec.Type {
+				path = obj.Spec.Path
+			}
+		}
+
+		if path != "" {
+			err := vaultAuthOp.DisableAuthMethod(path, token)
+			if err != nil {
+				// requeue on error for proper cleaning
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
 		}
 
 		patch := client.MergeFrom(obj.DeepCopy())
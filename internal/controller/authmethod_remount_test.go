@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+func movingAuthMethod(status v1alpha1.AuthMethodStatus) *v1alpha1.AuthMethod {
+	status.MountedPath = "old"
+	return &v1alpha1.AuthMethod{
+		ObjectMeta: metav1.ObjectMeta{Name: "userpass", Namespace: "default", Generation: 2},
+		Spec:       v1alpha1.AuthMethodSpec{Type: "userpass", Path: "new"},
+		Status:     status,
+	}
+}
+
+func TestAuthMethodMovePathKeepsFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	obj := movingAuthMethod(v1alpha1.AuthMethodStatus{
+		MigrationID:         "migration-1",
+		MigrationStatus:     cvault.MigrationInProgress,
+		MigrationGeneration: 2,
+	})
+	r := &AuthMethodReconciler{Client: newFakeClient(t, obj)}
+	vc := &fakeVaultClient{migrationStatus: cvault.MigrationFailure}
+	op := cvault.NewAuthOperator(vc)
+
+	_, err := r.movePath(ctx, obj, op, "token")
+	require.NoError(t, err)
+
+	latest := &v1alpha1.AuthMethod{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(obj), latest))
+	assert.Equal(t, "migration-1", latest.Status.MigrationID)
+	assert.Equal(t, cvault.MigrationFailure, latest.Status.MigrationStatus)
+	assert.Equal(t, "old", latest.Status.MountedPath)
+
+	// the next reconcile of the same generation neither polls nor remounts
+	result, err := r.movePath(ctx, latest, op, "token")
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Empty(t, vc.remounts)
+}
+
+func TestAuthMethodMovePathRetriesAfterSpecChange(t *testing.T) {
+	ctx := context.Background()
+	obj := movingAuthMethod(v1alpha1.AuthMethodStatus{
+		MigrationID:         "migration-1",
+		MigrationStatus:     cvault.MigrationFailure,
+		MigrationGeneration: 1,
+	})
+	r := &AuthMethodReconciler{Client: newFakeClient(t, obj)}
+	vc := &fakeVaultClient{mounts: map[string]interface{}{"old/": map[string]interface{}{"type": "userpass"}}}
+
+	_, err := r.movePath(ctx, obj, cvault.NewAuthOperator(vc), "token")
+	require.NoError(t, err)
+	require.Len(t, vc.remounts, 1)
+	assert.Equal(t, "auth/new", vc.remounts[0].To)
+
+	latest := &v1alpha1.AuthMethod{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(obj), latest))
+	assert.Equal(t, "migration-2", latest.Status.MigrationID)
+	assert.Equal(t, cvault.MigrationInProgress, latest.Status.MigrationStatus)
+	assert.Equal(t, int64(2), latest.Status.MigrationGeneration)
+}
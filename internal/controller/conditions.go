@@ -0,0 +1,47 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+// setReadyCondition mirrors the outcome of a reconcile into the Ready condition.
+func setReadyCondition(conditions *[]metav1.Condition, generation int64, ready bool, reason string, message string) {
+	condition := metav1.Condition{
+		Type:               v1alpha1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	if ready {
+		condition.Status = metav1.ConditionTrue
+	}
+
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// readyAtGeneration tells whether the last reconcile of the current generation
+// succeeded, in which case a change found in Vault means it drifted there.
+func readyAtGeneration(conditions []metav1.Condition, generation int64) bool {
+	ready := meta.FindStatusCondition(conditions, v1alpha1.ConditionReady)
+	return ready != nil && ready.Status == metav1.ConditionTrue && ready.ObservedGeneration == generation
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	databaseConnectionFinalizer = "databaseconnection.finalizers.ops.community.dev"
+)
+
+// DatabaseConnectionReconciler reconciles a DatabaseConnection object
+type DatabaseConnectionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseconnections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseconnections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseconnections/finalizers,verbs=update
+
+// Reconcile writes the connection into the database secret engine referenced
+// by the DatabaseConnection, optionally rotates its root credentials and
+// removes the connection from Vault when the object is deleted.
+func (r *DatabaseConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Database Connection Reconciliation")
+
+	obj := &v1alpha1.DatabaseConnection{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "database")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its connections with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	dbOp := cvault.NewDatabaseOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, dbOp, secretEngine.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, databaseConnectionFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, databaseConnectionFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	credentials := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Name: obj.Spec.CredentialsSecretRef.Name, Namespace: req.Namespace}, credentials); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get credentials secret %s: %v", obj.Spec.CredentialsSecretRef.Name, err), errorRequeueTime)
+	}
+
+	settings, password, err := databaseConnectionData(obj, credentials)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read credentials: %v", err), errorRequeueTime)
+	}
+
+	credentialsChanged := credentials.ResourceVersion != obj.Status.CredentialsVersion
+	_, err = dbOp.EnsureConnection(ctx, secretEngine.Spec.Path, obj.Spec.Name, password, credentialsChanged, settings, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to configure database connection: %v", err), errorRequeueTime)
+	}
+	obj.Status.CredentialsVersion = credentials.ResourceVersion
+
+	switch {
+	case obj.Spec.RotateRootCredentials && (obj.Status.RootRotationTime == nil || credentialsChanged):
+		if err := dbOp.RotateRootCredentials(ctx, secretEngine.Spec.Path, obj.Spec.Name, vaultOpInstance.Token); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to rotate root credentials: %v", err), errorRequeueTime)
+		}
+		obj.Status.RootRotationTime = &metav1.Time{Time: time.Now()}
+	case !obj.Spec.RotateRootCredentials && credentialsChanged:
+		// the secret password was written again and is the valid one now
+		obj.Status.RootRotationTime = nil
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Database connection synchronized successfully", defaultRequeueTime)
+}
+
+// databaseConnectionData returns the settings of the raw connection request
+// and the password of the credentials Secret. Once the root credentials were
+// rotated that password is stale, so it is only sent again when the Secret
+// changed.
+func databaseConnectionData(obj *v1alpha1.DatabaseConnection, credentials *corev1.Secret) (map[string]interface{}, string, error) {
+	ref := obj.Spec.CredentialsSecretRef
+
+	username, ok := credentials.Data[ref.UsernameKey]
+	if !ok {
+		return nil, "", fmt.Errorf("key %s not found in secret %s", ref.UsernameKey, ref.Name)
+	}
+	password, ok := credentials.Data[ref.PasswordKey]
+	if !ok {
+		return nil, "", fmt.Errorf("key %s not found in secret %s", ref.PasswordKey, ref.Name)
+	}
+
+	data := map[string]interface{}{
+		"plugin_name":    obj.Spec.PluginName,
+		"connection_url": obj.Spec.ConnectionURL,
+		"allowed_roles":  cvault.NonNilStrings(obj.Spec.AllowedRoles),
+		"username":       string(username),
+	}
+
+	setIfNotEmpty(data, "plugin_version", obj.Spec.PluginVersion)
+	setIfNotEmpty(data, "password_policy", obj.Spec.PasswordPolicy)
+	setIfNotEmpty(data, "root_rotation_statements", obj.Spec.RootRotationStatements)
+	if obj.Spec.VerifyConnection != nil {
+		data["verify_connection"] = *obj.Spec.VerifyConnection
+	}
+
+	return data, string(password), nil
+}
+
+func (r *DatabaseConnectionReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.DatabaseConnection, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.DatabaseConnection{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.CredentialsVersion = obj.Status.CredentialsVersion
+		latest.Status.RootRotationTime = obj.Status.RootRotationTime
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *DatabaseConnectionReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.DatabaseConnection, dbOp *cvault.DatabaseOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, databaseConnectionFinalizer) {
+		err := dbOp.DeleteConnection(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *DatabaseConnectionReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.DatabaseConnection) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, databaseConnectionFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, databaseConnectionFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DatabaseConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.DatabaseConnection{}, specChanged).
+		Named("databaseconnection").
+		Complete(r)
+}
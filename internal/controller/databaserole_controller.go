@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	databaseRoleFinalizer = "databaserole.finalizers.ops.community.dev"
+)
+
+// DatabaseRoleReconciler reconciles a DatabaseRole object
+type DatabaseRoleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=databaseroles/finalizers,verbs=update
+
+// Reconcile writes the role into the database secret engine of the referenced
+// DatabaseConnection and removes it from Vault when the object is deleted.
+func (r *DatabaseRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Database Role Reconciliation")
+
+	obj := &v1alpha1.DatabaseRole{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	connection, err := getDatabaseConnection(ctx, r.Client, req.Namespace, obj.Spec.Connection)
+	if err == nil {
+		var secretEngine *v1alpha1.SecretEngine
+		secretEngine, err = getSecretEngine(ctx, r.Client, req.Namespace, connection.Spec.SecretEngine, "database")
+		if err == nil {
+			return r.reconcileRole(ctx, obj, connection, secretEngine)
+		}
+	}
+
+	if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+		// without connection or secret engine there is no mount left to clean up
+		return r.removeFinalizer(ctx, obj)
+	}
+	return r.updateStatus(ctx, obj, false,
+		fmt.Sprintf("Failed to resolve database connection: %v", err), errorRequeueTime)
+}
+
+func (r *DatabaseRoleReconciler) reconcileRole(ctx context.Context, obj *v1alpha1.DatabaseRole, connection *v1alpha1.DatabaseConnection, secretEngine *v1alpha1.SecretEngine) (ctrl.Result, error) {
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	dbOp := cvault.NewDatabaseOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, dbOp, secretEngine.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, databaseRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, databaseRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = dbOp.CreateOrUpdateRole(ctx, secretEngine.Spec.Path, obj.Spec.Name, databaseRoleRequest(obj.Spec, connection.Spec.Name), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update database role: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Database role synchronized successfully", defaultRequeueTime)
+}
+
+func databaseRoleRequest(spec v1alpha1.DatabaseRoleSpec, dbName string) schema.DatabaseWriteRoleRequest {
+	return schema.DatabaseWriteRoleRequest{
+		DbName:               dbName,
+		CreationStatements:   spec.CreationStatements,
+		RevocationStatements: spec.RevocationStatements,
+		RollbackStatements:   spec.RollbackStatements,
+		RenewStatements:      spec.RenewStatements,
+		DefaultTtl:           spec.DefaultTTL,
+		MaxTtl:               spec.MaxTTL,
+		CredentialType:       spec.CredentialType,
+	}
+}
+
+func (r *DatabaseRoleReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.DatabaseRole, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.DatabaseRole{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *DatabaseRoleReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.DatabaseRole, dbOp *cvault.DatabaseOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, databaseRoleFinalizer) {
+		err := dbOp.DeleteRole(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *DatabaseRoleReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.DatabaseRole) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, databaseRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, databaseRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DatabaseRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.DatabaseRole{}, specChanged).
+		Named("databaserole").
+		Complete(r)
+}
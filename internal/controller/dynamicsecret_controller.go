@@ -0,0 +1,395 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	dynamicSecretFinalizer = "dynamicsecret.finalizers.ops.community.dev"
+)
+
+// DynamicSecretReconciler reconciles a DynamicSecret object
+type DynamicSecretReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=dynamicsecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=dynamicsecrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=dynamicsecrets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile reads a dynamic path into a Secret owned by the DynamicSecret and
+// keeps its lease alive. Once Vault stops granting the full lease duration,
+// the max TTL is close and fresh credentials are read instead. The previous
+// lease stays valid for revokeGracePeriod, pods may still use it, then it is
+// revoked. All leases are revoked when the object is deleted.
+func (r *DynamicSecretReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Dynamic Secret Reconciliation")
+
+	obj := &v1alpha1.DynamicSecret{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine)
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its leases with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	leaseOp := cvault.NewLeaseOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, leaseOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, dynamicSecretFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, dynamicSecretFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := r.revokeRetiredLeases(ctx, obj, leaseOp, vaultOpInstance.Token); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to revoke replaced lease: %v", err), errorRequeueTime)
+	}
+
+	reason, err := r.issueReason(ctx, obj)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read target secret: %v", err), errorRequeueTime)
+	}
+
+	if reason == "" && obj.Status.RenewalTime != nil && !time.Now().Before(obj.Status.RenewalTime.Time) {
+		reason = r.renewLease(ctx, obj, leaseOp, vaultOpInstance.Token)
+		if reason == "" {
+			return r.updateStatus(ctx, obj, true,
+				fmt.Sprintf("Lease renewed, expires at %s", obj.Status.LeaseExpiry.UTC().Format(time.RFC3339)),
+				dynamicSecretRequeue(obj))
+		}
+	}
+
+	if reason == "" {
+		return r.updateStatus(ctx, obj, true,
+			"Dynamic secret synchronized successfully", dynamicSecretRequeue(obj))
+	}
+
+	logger.Info("Reading dynamic secret", "reason", reason)
+	path := strings.TrimSuffix(secretEngine.Spec.Path, "/") + "/" + strings.TrimPrefix(obj.Spec.Path, "/")
+	lease, err := leaseOp.ReadDynamicSecret(ctx, path, obj.Spec.Method == "write", obj.Spec.Parameters, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read %s: %v", path, err), errorRequeueTime)
+	}
+
+	if err := r.writeSecret(ctx, obj, lease.Data); err != nil {
+		// nobody received the new credentials, do not leave them valid
+		r.revokeLease(ctx, leaseOp, lease.LeaseID, vaultOpInstance.Token)
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to write secret: %v", err), errorRequeueTime)
+	}
+
+	now := time.Now()
+	// the previous credentials are replaced, revoke them once pods had the
+	// grace period to pick up the new ones
+	if previous := obj.Status.LeaseID; previous != "" && previous != lease.LeaseID {
+		obj.Status.RetiredLeases = append(obj.Status.RetiredLeases, v1alpha1.RetiredLease{
+			LeaseID:     previous,
+			RevokeAfter: metav1.Time{Time: now.Add(revokeGracePeriod(obj))},
+		})
+	}
+
+	obj.Status.LeaseID = lease.LeaseID
+	obj.Status.LeaseDuration = int64(lease.Duration.Seconds())
+	obj.Status.Renewable = lease.Renewable
+	obj.Status.IssueTime = &metav1.Time{Time: now}
+	obj.Status.IssuedGeneration = obj.Generation
+	obj.Status.IssuedSpecHash = dynamicSecretSpecHash(obj.Spec)
+	obj.Status.LeaseExpiry = nil
+	obj.Status.RenewalTime = nil
+	if lease.Duration > 0 {
+		expiry := now.Add(lease.Duration)
+		obj.Status.LeaseExpiry = &metav1.Time{Time: expiry}
+		obj.Status.RenewalTime = &metav1.Time{Time: renewalTime(now, expiry, obj.Spec.RenewAtPercent)}
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		fmt.Sprintf("Dynamic secret read (%s)", reason), dynamicSecretRequeue(obj))
+}
+
+// issueReason tells why the dynamic path has to be read again, or returns an
+// empty string when the current secret is still good.
+func (r *DynamicSecretReconciler) issueReason(ctx context.Context, obj *v1alpha1.DynamicSecret) (string, error) {
+	if obj.Status.IssueTime == nil {
+		return "not read yet", nil
+	}
+	if obj.Status.IssuedSpecHash != dynamicSecretSpecHash(obj.Spec) {
+		return "spec changed", nil
+	}
+	if obj.Status.LeaseExpiry != nil && !time.Now().Before(obj.Status.LeaseExpiry.Time) {
+		return "lease expired", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: dynamicSecretName(obj), Namespace: obj.Namespace}, secret)
+	if errors.IsNotFound(err) {
+		return "secret missing", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+// dynamicSecretSpecHash hashes the spec fields that change what is read from
+// Vault, so edits to e.g. renewAtPercent keep the current credentials.
+func dynamicSecretSpecHash(spec v1alpha1.DynamicSecretSpec) string {
+	// maps are marshalled with sorted keys, so the hash is stable
+	data, _ := json.Marshal(struct {
+		SecretEngine v1alpha1.SecretEngineReference
+		Path         string
+		Method       string
+		Parameters   map[string]string
+	}{spec.SecretEngine, spec.Path, spec.Method, spec.Parameters})
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func revokeGracePeriod(obj *v1alpha1.DynamicSecret) time.Duration {
+	if obj.Spec.RevokeGracePeriod != nil {
+		return obj.Spec.RevokeGracePeriod.Duration
+	}
+	return 10 * time.Minute
+}
+
+// revokeRetiredLeases revokes retired leases whose grace period is over.
+func (r *DynamicSecretReconciler) revokeRetiredLeases(ctx context.Context, obj *v1alpha1.DynamicSecret, leaseOp *cvault.LeaseOperator, token string) error {
+	now := time.Now()
+	remaining := obj.Status.RetiredLeases[:0]
+	var revokeErr error
+
+	for _, retired := range obj.Status.RetiredLeases {
+		if revokeErr == nil && !now.Before(retired.RevokeAfter.Time) {
+			revokeErr = leaseOp.RevokeLease(ctx, retired.LeaseID, token)
+			if revokeErr == nil {
+				continue
+			}
+		}
+		remaining = append(remaining, retired)
+	}
+
+	obj.Status.RetiredLeases = remaining
+	return revokeErr
+}
+
+// revokeLease revokes a lease that is no longer handed out. A failure is only
+// logged since the lease still expires on its own.
+func (r *DynamicSecretReconciler) revokeLease(ctx context.Context, leaseOp *cvault.LeaseOperator, leaseID string, token string) {
+	if leaseID == "" {
+		return
+	}
+	if err := leaseOp.RevokeLease(ctx, leaseID, token); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to revoke replaced lease", "leaseId", leaseID)
+	}
+}
+
+// renewLease renews the current lease for its original duration and updates
+// the status. It returns why the secret must be read again instead, if so.
+func (r *DynamicSecretReconciler) renewLease(ctx context.Context, obj *v1alpha1.DynamicSecret, leaseOp *cvault.LeaseOperator, token string) string {
+	if !obj.Status.Renewable {
+		return "lease not renewable"
+	}
+
+	duration := time.Duration(obj.Status.LeaseDuration) * time.Second
+	granted, err := leaseOp.RenewLease(ctx, obj.Status.LeaseID, duration, token)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to renew lease", "leaseId", obj.Status.LeaseID)
+		return "lease renewal failed"
+	}
+	if granted < duration {
+		return "max TTL approaching"
+	}
+
+	now := time.Now()
+	expiry := now.Add(granted)
+	obj.Status.LeaseExpiry = &metav1.Time{Time: expiry}
+	obj.Status.RenewalTime = &metav1.Time{Time: renewalTime(now, expiry, obj.Spec.RenewAtPercent)}
+	return ""
+}
+
+func (r *DynamicSecretReconciler) writeSecret(ctx context.Context, obj *v1alpha1.DynamicSecret, data map[string]string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dynamicSecretName(obj),
+			Namespace: obj.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := checkControlled(secret, obj); err != nil {
+			return err
+		}
+
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = make(map[string][]byte, len(data))
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+
+		return controllerutil.SetControllerReference(obj, secret, r.Scheme)
+	})
+
+	return err
+}
+
+func dynamicSecretName(obj *v1alpha1.DynamicSecret) string {
+	if obj.Spec.SecretName != "" {
+		return obj.Spec.SecretName
+	}
+	return obj.Name
+}
+
+// dynamicSecretRequeue requeues at the renewal time or the next revocation of
+// a retired lease, but at least every defaultRequeueTime to notice a deleted
+// Secret.
+func dynamicSecretRequeue(obj *v1alpha1.DynamicSecret) time.Duration {
+	requeue := defaultRequeueTime
+	if obj.Status.RenewalTime != nil {
+		requeue = min(requeue, time.Until(obj.Status.RenewalTime.Time))
+	}
+	for _, retired := range obj.Status.RetiredLeases {
+		requeue = min(requeue, time.Until(retired.RevokeAfter.Time))
+	}
+	return max(requeue, time.Second)
+}
+
+func (r *DynamicSecretReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.DynamicSecret, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.DynamicSecret{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.LeaseID = obj.Status.LeaseID
+		latest.Status.LeaseDuration = obj.Status.LeaseDuration
+		latest.Status.Renewable = obj.Status.Renewable
+		latest.Status.IssueTime = obj.Status.IssueTime
+		latest.Status.LeaseExpiry = obj.Status.LeaseExpiry
+		latest.Status.RenewalTime = obj.Status.RenewalTime
+		latest.Status.IssuedGeneration = obj.Status.IssuedGeneration
+		latest.Status.IssuedSpecHash = obj.Status.IssuedSpecHash
+		latest.Status.RetiredLeases = obj.Status.RetiredLeases
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *DynamicSecretReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.DynamicSecret, leaseOp *cvault.LeaseOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, dynamicSecretFinalizer) {
+		leaseIDs := []string{obj.Status.LeaseID}
+		for _, retired := range obj.Status.RetiredLeases {
+			leaseIDs = append(leaseIDs, retired.LeaseID)
+		}
+		for _, leaseID := range leaseIDs {
+			if leaseID == "" {
+				continue
+			}
+			if err := leaseOp.RevokeLease(ctx, leaseID, token); err != nil {
+				// requeue on error for proper cleaning
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *DynamicSecretReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.DynamicSecret) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, dynamicSecretFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, dynamicSecretFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DynamicSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.DynamicSecret{}, specChanged).
+		Owns(&corev1.Secret{}).
+		Named("dynamicsecret").
+		Complete(r)
+}
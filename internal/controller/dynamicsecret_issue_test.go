@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+func TestDynamicSecretIssueReason(t *testing.T) {
+	issued := func() *v1alpha1.DynamicSecret {
+		obj := &v1alpha1.DynamicSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default", Generation: 1},
+			Spec: v1alpha1.DynamicSecretSpec{
+				SecretEngine:   v1alpha1.SecretEngineReference{Name: "database"},
+				Path:           "creds/readonly",
+				Method:         "read",
+				RenewAtPercent: 66,
+			},
+		}
+		obj.Status.IssueTime = &metav1.Time{Time: time.Now()}
+		obj.Status.IssuedGeneration = 1
+		obj.Status.IssuedSpecHash = dynamicSecretSpecHash(obj.Spec)
+		return obj
+	}
+
+	testCases := []struct {
+		name   string
+		mutate func(obj *v1alpha1.DynamicSecret)
+		reason string
+	}{
+		{
+			name:   "unchanged",
+			mutate: func(obj *v1alpha1.DynamicSecret) {},
+		},
+		{
+			name: "renewal setting changed",
+			mutate: func(obj *v1alpha1.DynamicSecret) {
+				obj.Generation = 2
+				obj.Spec.RenewAtPercent = 80
+			},
+		},
+		{
+			name: "path changed",
+			mutate: func(obj *v1alpha1.DynamicSecret) {
+				obj.Generation = 2
+				obj.Spec.Path = "creds/admin"
+			},
+			reason: "spec changed",
+		},
+		{
+			name: "parameters changed",
+			mutate: func(obj *v1alpha1.DynamicSecret) {
+				obj.Generation = 2
+				obj.Spec.Parameters = map[string]string{"ttl": "1h"}
+			},
+			reason: "spec changed",
+		},
+		{
+			name: "status without hash",
+			mutate: func(obj *v1alpha1.DynamicSecret) {
+				obj.Status.IssuedSpecHash = ""
+			},
+			reason: "spec changed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := issued()
+			tc.mutate(obj)
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"}}
+			r := &DynamicSecretReconciler{Client: newFakeClient(t, secret)}
+
+			reason, err := r.issueReason(context.Background(), obj)
+			require.NoError(t, err)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestRevokeRetiredLeases(t *testing.T) {
+	now := time.Now()
+	obj := &v1alpha1.DynamicSecret{}
+	obj.Status.RetiredLeases = []v1alpha1.RetiredLease{
+		{LeaseID: "database/creds/readonly/old", RevokeAfter: metav1.Time{Time: now.Add(-time.Minute)}},
+		{LeaseID: "database/creds/readonly/recent", RevokeAfter: metav1.Time{Time: now.Add(time.Minute)}},
+	}
+	vc := &fakeVaultClient{}
+	r := &DynamicSecretReconciler{}
+
+	err := r.revokeRetiredLeases(context.Background(), obj, cvault.NewLeaseOperator(vc), "token")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"database/creds/readonly/old"}, vc.revoked)
+	require.Len(t, obj.Status.RetiredLeases, 1)
+	assert.Equal(t, "database/creds/readonly/recent", obj.Status.RetiredLeases[0].LeaseID)
+
+	assert.LessOrEqual(t, dynamicSecretRequeue(obj), time.Minute)
+}
+
+func TestRevokeRetiredLeasesKeepsFailed(t *testing.T) {
+	obj := &v1alpha1.DynamicSecret{}
+	obj.Status.RetiredLeases = []v1alpha1.RetiredLease{
+		{LeaseID: "database/creds/readonly/old", RevokeAfter: metav1.Time{Time: time.Now().Add(-time.Minute)}},
+	}
+	vc := &fakeVaultClient{revokeErr: errors.New("connection refused")}
+	r := &DynamicSecretReconciler{}
+
+	err := r.revokeRetiredLeases(context.Background(), obj, cvault.NewLeaseOperator(vc), "token")
+	assert.Error(t, err)
+	assert.Len(t, obj.Status.RetiredLeases, 1)
+}
+
+func TestDynamicSecretWriteSecretRefusesForeignSecret(t *testing.T) {
+	ctx := context.Background()
+	obj := &v1alpha1.DynamicSecret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default", UID: "uid-1"},
+		Spec:       v1alpha1.DynamicSecretSpec{SecretName: "app"},
+	}
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("keep-me")},
+	}
+	c := newFakeClient(t, obj, foreign)
+	r := &DynamicSecretReconciler{Client: c, Scheme: c.Scheme()}
+
+	err := r.writeSecret(ctx, obj, map[string]string{"password": "new"})
+	assert.Error(t, err)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "app", Namespace: "default"}, secret))
+	assert.Equal(t, "keep-me", string(secret.Data["password"]))
+
+	obj.Spec.SecretName = "owned"
+	require.NoError(t, r.writeSecret(ctx, obj, map[string]string{"password": "new"}))
+	require.NoError(t, r.writeSecret(ctx, obj, map[string]string{"password": "newer"}))
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "owned", Namespace: "default"}, secret))
+	assert.Equal(t, "newer", string(secret.Data["password"]))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+// fakeVaultClient records generic writes and answers them from responses
+// and errors keyed by path. Calls it does not override panic through the
+// nil embedded interface.
+type fakeVaultClient struct {
+	cvault.VaultClientI
+
+	roleID    string
+	writes    map[string]map[string]interface{}
+	responses map[string]*vault.Response[map[string]interface{}]
+	errors    map[string]error
+
+	mounts          map[string]interface{}
+	remounts        []schema.RemountRequest
+	remountErr      error
+	migrationStatus string
+	revoked         []string
+	revokeErr       error
+}
+
+func (f *fakeVaultClient) Write(ctx context.Context, path string, body map[string]interface{}, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if f.writes == nil {
+		f.writes = map[string]map[string]interface{}{}
+	}
+	f.writes[path] = body
+	if err, ok := f.errors[path]; ok {
+		return nil, err
+	}
+	if resp, ok := f.responses[path]; ok {
+		return resp, nil
+	}
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (f *fakeVaultClient) Read(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if err, ok := f.errors[path]; ok {
+		return nil, err
+	}
+	if resp, ok := f.responses[path]; ok {
+		return resp, nil
+	}
+	return nil, &vault.ResponseError{StatusCode: 404}
+}
+
+func (f *fakeVaultClient) Delete(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(f.responses, path)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (f *fakeVaultClient) GetAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[schema.AppRoleReadRoleIdResponse], error) {
+	return &vault.Response[schema.AppRoleReadRoleIdResponse]{Data: schema.AppRoleReadRoleIdResponse{RoleId: f.roleID}}, nil
+}
+
+func (f *fakeVaultClient) DeleteAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (f *fakeVaultClient) ListMounts(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{Data: f.mounts}, nil
+}
+
+// ListAuthMethods shares the mount table with ListMounts.
+func (f *fakeVaultClient) ListAuthMethods(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{Data: f.mounts}, nil
+}
+
+func (f *fakeVaultClient) Remount(ctx context.Context, request schema.RemountRequest, options ...vault.RequestOption) (*vault.Response[schema.RemountResponse], error) {
+	f.remounts = append(f.remounts, request)
+	if f.remountErr != nil {
+		return nil, f.remountErr
+	}
+	return &vault.Response[schema.RemountResponse]{Data: schema.RemountResponse{MigrationId: "migration-2"}}, nil
+}
+
+func (f *fakeVaultClient) RemountStatus(ctx context.Context, migrationID string, options ...vault.RequestOption) (*vault.Response[schema.RemountStatusResponse], error) {
+	return &vault.Response[schema.RemountStatusResponse]{
+		Data: schema.RemountStatusResponse{MigrationInfo: map[string]interface{}{"status": f.migrationStatus}},
+	}, nil
+}
+
+func (f *fakeVaultClient) LeasesRevokeLease(ctx context.Context, request schema.LeasesRevokeLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if f.revokeErr != nil {
+		return nil, f.revokeErr
+	}
+	f.revoked = append(f.revoked, request.LeaseId)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+// newFakeClient returns a Kubernetes client backed by memory, for tests that
+// do not need the envtest API server.
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, v1alpha1.AddToScheme(s))
+
+	return fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.AppRole{}, &v1alpha1.AuthMethod{}, &v1alpha1.DynamicSecret{}, &v1alpha1.SecretEngine{}).
+		Build()
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	identityEntityFinalizer = "identityentity.finalizers.ops.community.dev"
+)
+
+// IdentityEntityReconciler reconciles a IdentityEntity object
+type IdentityEntityReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentities/finalizers,verbs=update
+
+// Reconcile writes the entity into the identity store of the vault server and
+// removes it, together with its aliases, when the object is deleted.
+func (r *IdentityEntityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Identity Entity Reconciliation")
+
+	obj := &v1alpha1.IdentityEntity{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	identityOp := cvault.NewIdentityOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, identityOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, identityEntityFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, identityEntityFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = identityOp.WriteEntity(ctx, obj.Spec.Name, identityEntityData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update identity entity: %v", err), errorRequeueTime)
+	}
+
+	obj.Status.EntityID, err = identityOp.ReadEntityID(ctx, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil || obj.Status.EntityID == "" {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read back identity entity: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Identity entity synchronized successfully", defaultRequeueTime)
+}
+
+// identityEntityData builds the raw entity request. Policies and metadata are
+// always sent so that removing the last one is applied as well.
+func identityEntityData(spec v1alpha1.IdentityEntitySpec) map[string]interface{} {
+	policies := spec.Policies
+	if policies == nil {
+		policies = []string{}
+	}
+
+	return map[string]interface{}{
+		"policies": policies,
+		"metadata": identityMetadata(spec.Metadata),
+		"disabled": spec.Disabled,
+	}
+}
+
+func identityMetadata(metadata map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		out[k] = v
+	}
+	return out
+}
+
+func (r *IdentityEntityReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.IdentityEntity, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.IdentityEntity{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.EntityID != "" {
+			latest.Status.EntityID = obj.Status.EntityID
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *IdentityEntityReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.IdentityEntity, identityOp *cvault.IdentityOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, identityEntityFinalizer) {
+		err := identityOp.DeleteEntity(ctx, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, identityEntityFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IdentityEntityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.IdentityEntity{}, specChanged).
+		Named("identityentity").
+		Complete(r)
+}
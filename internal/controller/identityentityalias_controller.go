@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	identityEntityAliasFinalizer = "identityentityalias.finalizers.ops.community.dev"
+)
+
+// IdentityEntityAliasReconciler reconciles a IdentityEntityAlias object
+type IdentityEntityAliasReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentityaliases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentityaliases/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identityentityaliases/finalizers,verbs=update
+
+// Reconcile binds a login name of an auth method to the referenced entity.
+// The alias is tracked by its id, as Vault does not allow changing the name
+// or mount accessor of an alias it is recreated when either changes.
+func (r *IdentityEntityAliasReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Identity Entity Alias Reconciliation")
+
+	obj := &v1alpha1.IdentityEntityAlias{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	entity, err := getIdentityEntity(ctx, r.Client, req.Namespace, obj.Spec.Entity)
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// Vault deletes the aliases of an entity together with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve identity entity: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, entity.Spec.VaultServer.Name, entity.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	identityOp := cvault.NewIdentityOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, identityOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, identityEntityAliasFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, identityEntityAliasFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if entity.Status.EntityID == "" {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Identity entity %s is not synchronized yet", entity.Name), errorRequeueTime)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	accessor, err := cvault.NewAuthOperator(vaultOpInstance.Client).MountAccessor(authMethod.Spec.Path, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get mount accessor: %v", err), errorRequeueTime)
+	}
+
+	aliasID, err := r.syncAlias(ctx, obj, identityOp, entity.Status.EntityID, accessor, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update identity entity alias: %v", err), errorRequeueTime)
+	}
+	obj.Status.AliasID = aliasID
+	obj.Status.MountAccessor = accessor
+
+	return r.updateStatus(ctx, obj, true,
+		"Identity entity alias synchronized successfully", defaultRequeueTime)
+}
+
+// syncAlias creates the alias or updates the one recorded in status, and
+// returns its id.
+func (r *IdentityEntityAliasReconciler) syncAlias(ctx context.Context, obj *v1alpha1.IdentityEntityAlias, identityOp *cvault.IdentityOperator, entityID string, accessor string, token string) (string, error) {
+	var alias *cvault.IdentityAlias
+	if obj.Status.AliasID != "" {
+		var err error
+		if alias, err = identityOp.ReadEntityAlias(ctx, obj.Status.AliasID, token); err != nil {
+			return "", err
+		}
+	}
+
+	if alias != nil && (alias.Name != obj.Spec.Name || alias.MountAccessor != accessor) {
+		if err := identityOp.DeleteEntityAlias(ctx, alias.ID, token); err != nil {
+			return "", err
+		}
+		alias = nil
+	}
+
+	if alias == nil {
+		return identityOp.CreateEntityAlias(ctx, schema.EntityCreateAliasRequest{
+			Name:           obj.Spec.Name,
+			MountAccessor:  accessor,
+			CanonicalId:    entityID,
+			CustomMetadata: identityMetadata(obj.Spec.CustomMetadata),
+		}, token)
+	}
+
+	// custom_metadata is always sent, so metadata removed from the spec is
+	// cleared in Vault
+	return alias.ID, identityOp.UpdateEntityAlias(ctx, alias.ID, map[string]interface{}{
+		"canonical_id":    entityID,
+		"custom_metadata": identityMetadata(obj.Spec.CustomMetadata),
+	}, token)
+}
+
+func (r *IdentityEntityAliasReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.IdentityEntityAlias, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.IdentityEntityAlias{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.AliasID != "" {
+			latest.Status.AliasID = obj.Status.AliasID
+			latest.Status.MountAccessor = obj.Status.MountAccessor
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *IdentityEntityAliasReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.IdentityEntityAlias, identityOp *cvault.IdentityOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, identityEntityAliasFinalizer) && obj.Status.AliasID != "" {
+		err := identityOp.DeleteEntityAlias(ctx, obj.Status.AliasID, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *IdentityEntityAliasReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.IdentityEntityAlias) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, identityEntityAliasFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, identityEntityAliasFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IdentityEntityAliasReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.IdentityEntityAlias{}, specChanged).
+		Named("identityentityalias").
+		Complete(r)
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	identityGroupFinalizer = "identitygroup.finalizers.ops.community.dev"
+)
+
+// IdentityGroupReconciler reconciles a IdentityGroup object
+type IdentityGroupReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identitygroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identitygroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=identitygroups/finalizers,verbs=update
+
+// Reconcile writes the group into the identity store of the vault server.
+// Members of internal groups are resolved from the referenced IdentityEntity
+// and IdentityGroup objects, external groups get their group alias bound to
+// the mount accessor of the referenced AuthMethod.
+func (r *IdentityGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Identity Group Reconciliation")
+
+	obj := &v1alpha1.IdentityGroup{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	identityOp := cvault.NewIdentityOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, identityOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, identityGroupFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, identityGroupFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := validateIdentityGroupSpec(obj.Spec); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	group, err := identityOp.ReadGroup(ctx, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read identity group: %v", err), errorRequeueTime)
+	}
+	if group != nil && group.Type != obj.Spec.Type {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Identity group %s has type %s, it cannot be changed to %s", obj.Spec.Name, group.Type, obj.Spec.Type), errorRequeueTime)
+	}
+
+	data, err := r.identityGroupData(ctx, obj)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve group members: %v", err), errorRequeueTime)
+	}
+
+	if err := identityOp.WriteGroup(ctx, obj.Spec.Name, data, vaultOpInstance.Token); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update identity group: %v", err), errorRequeueTime)
+	}
+
+	group, err = identityOp.ReadGroup(ctx, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil || group == nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read back identity group: %v", err), errorRequeueTime)
+	}
+	obj.Status.GroupID = group.ID
+
+	if obj.Spec.Type == "external" {
+		obj.Status.AliasID, err = r.syncAlias(ctx, obj, identityOp, vaultOpInstance, group)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to sync identity group alias: %v", err), errorRequeueTime)
+		}
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Identity group synchronized successfully", defaultRequeueTime)
+}
+
+func validateIdentityGroupSpec(spec v1alpha1.IdentityGroupSpec) error {
+	if spec.Type == "external" && (len(spec.MemberEntities) > 0 || len(spec.MemberGroups) > 0) {
+		return fmt.Errorf("members of an external group are managed by its auth method")
+	}
+	if spec.Type != "external" && spec.Alias != nil {
+		return fmt.Errorf("alias is only supported for external groups")
+	}
+	return nil
+}
+
+// identityGroupData builds the raw group request. Lists are always sent so
+// that removing the last member or policy is applied as well.
+func (r *IdentityGroupReconciler) identityGroupData(ctx context.Context, obj *v1alpha1.IdentityGroup) (map[string]interface{}, error) {
+	policies := obj.Spec.Policies
+	if policies == nil {
+		policies = []string{}
+	}
+
+	data := map[string]interface{}{
+		"type":     obj.Spec.Type,
+		"policies": policies,
+		"metadata": identityMetadata(obj.Spec.Metadata),
+	}
+	if obj.Spec.Type == "external" {
+		return data, nil
+	}
+
+	entityIDs := []string{}
+	for _, ref := range obj.Spec.MemberEntities {
+		entity, err := getIdentityEntity(ctx, r.Client, obj.Namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		if entity.Status.EntityID == "" {
+			return nil, fmt.Errorf("identity entity %s is not synchronized yet", ref.Name)
+		}
+		entityIDs = append(entityIDs, entity.Status.EntityID)
+	}
+
+	groupIDs := []string{}
+	for _, ref := range obj.Spec.MemberGroups {
+		group, err := getIdentityGroup(ctx, r.Client, obj.Namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		if group.Status.GroupID == "" {
+			return nil, fmt.Errorf("identity group %s is not synchronized yet", ref.Name)
+		}
+		groupIDs = append(groupIDs, group.Status.GroupID)
+	}
+
+	data["member_entity_ids"] = entityIDs
+	data["member_group_ids"] = groupIDs
+	return data, nil
+}
+
+// syncAlias creates, updates or removes the alias of an external group and
+// returns its id.
+func (r *IdentityGroupReconciler) syncAlias(ctx context.Context, obj *v1alpha1.IdentityGroup, identityOp *cvault.IdentityOperator, vaultOpInstance *VaultOperatorClient, group *cvault.IdentityGroup) (string, error) {
+	token := vaultOpInstance.Token
+	if obj.Spec.Alias == nil {
+		if group.Alias != nil {
+			return "", identityOp.DeleteGroupAlias(ctx, group.Alias.ID, token)
+		}
+		return "", nil
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, obj.Namespace, obj.Spec.Alias.AuthMethod)
+	if err != nil {
+		return "", err
+	}
+
+	accessor, err := cvault.NewAuthOperator(vaultOpInstance.Client).MountAccessor(authMethod.Spec.Path, token)
+	if err != nil {
+		return "", err
+	}
+
+	if group.Alias == nil {
+		return identityOp.CreateGroupAlias(ctx, schema.GroupCreateAliasRequest{
+			Name:          obj.Spec.Alias.Name,
+			MountAccessor: accessor,
+			CanonicalId:   group.ID,
+		}, token)
+	}
+
+	if group.Alias.Name != obj.Spec.Alias.Name || group.Alias.MountAccessor != accessor {
+		err = identityOp.UpdateGroupAlias(ctx, group.Alias.ID, schema.GroupUpdateAliasByIdRequest{
+			Name:          obj.Spec.Alias.Name,
+			MountAccessor: accessor,
+			CanonicalId:   group.ID,
+		}, token)
+	}
+
+	return group.Alias.ID, err
+}
+
+func (r *IdentityGroupReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.IdentityGroup, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.IdentityGroup{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.GroupID != "" {
+			latest.Status.GroupID = obj.Status.GroupID
+			latest.Status.AliasID = obj.Status.AliasID
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *IdentityGroupReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.IdentityGroup, identityOp *cvault.IdentityOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, identityGroupFinalizer) {
+		// the group alias is deleted together with the group
+		err := identityOp.DeleteGroup(ctx, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, identityGroupFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IdentityGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.IdentityGroup{}, specChanged).
+		Named("identitygroup").
+		Complete(r)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JWTAuthConfigReconciler reconciles a JWTAuthConfig object
+type JWTAuthConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthconfigs/finalizers,verbs=update
+
+// Reconcile writes the jwt/oidc auth method configuration of the referenced
+// AuthMethod. Like the kubernetes config, it has no delete endpoint in Vault
+// and is removed together with the mount.
+func (r *JWTAuthConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting JWT Auth Config Reconciliation")
+
+	obj := &v1alpha1.JWTAuthConfig{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if err := r.validateSpec(obj); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod, "jwt", "oidc")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, authMethod.Spec.VaultServer.Name, authMethod.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	var clientSecret string
+	if obj.Spec.OIDCClientSecretRef != nil {
+		clientSecret, err = getSecretKeyValue(ctx, r.Client, req.Namespace, obj.Spec.OIDCClientSecretRef)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to read oidc client secret: %v", err), errorRequeueTime)
+		}
+	}
+
+	jwtAuthOp := cvault.NewJWTAuthOperator(vaultOpInstance.Client)
+	err = jwtAuthOp.ConfigureAuth(ctx, authMethod.Spec.Path, jwtAuthConfigData(obj.Spec, clientSecret), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to configure jwt auth method: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"JWT auth config synchronized successfully", defaultRequeueTime)
+}
+
+// jwtAuthConfigData sends every managed parameter, so settings removed from
+// the spec, such as a key source replaced by another, are reset in Vault.
+func jwtAuthConfigData(spec v1alpha1.JWTAuthConfigSpec, clientSecret string) map[string]interface{} {
+	return map[string]interface{}{
+		"oidc_discovery_url":     spec.OIDCDiscoveryURL,
+		"oidc_discovery_ca_pem":  spec.OIDCDiscoveryCAPEM,
+		"oidc_client_id":         spec.OIDCClientID,
+		"oidc_client_secret":     clientSecret,
+		"jwks_url":               spec.JWKSURL,
+		"jwks_ca_pem":            spec.JWKSCAPEM,
+		"jwt_validation_pubkeys": cvault.NonNilStrings(spec.JWTValidationPubkeys),
+		"jwt_supported_algs":     cvault.NonNilStrings(spec.JWTSupportedAlgs),
+		"bound_issuer":           spec.BoundIssuer,
+		"default_role":           spec.DefaultRole,
+	}
+}
+
+func (r *JWTAuthConfigReconciler) validateSpec(obj *v1alpha1.JWTAuthConfig) error {
+	sources := 0
+	if obj.Spec.OIDCDiscoveryURL != "" {
+		sources++
+	}
+	if obj.Spec.JWKSURL != "" {
+		sources++
+	}
+	if len(obj.Spec.JWTValidationPubkeys) > 0 {
+		sources++
+	}
+
+	if sources != 1 {
+		return fmt.Errorf("exactly one of oidcDiscoveryUrl, jwksUrl or jwtValidationPubkeys must be set")
+	}
+	return nil
+}
+
+func (r *JWTAuthConfigReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.JWTAuthConfig, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.JWTAuthConfig{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *JWTAuthConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.JWTAuthConfig{}, specChanged).
+		Named("jwtauthconfig").
+		Complete(r)
+}
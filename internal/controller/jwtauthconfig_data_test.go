@@ -0,0 +1,38 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestJWTAuthConfigDataResetsOtherKeySources(t *testing.T) {
+	data := jwtAuthConfigData(v1alpha1.JWTAuthConfigSpec{
+		JWKSURL:     "https://ci.example.com/.well-known/jwks",
+		BoundIssuer: "https://ci.example.com",
+	}, "")
+
+	assert.Equal(t, "https://ci.example.com/.well-known/jwks", data["jwks_url"])
+	assert.Equal(t, "", data["oidc_discovery_url"])
+	assert.Equal(t, "", data["oidc_client_secret"])
+	assert.Equal(t, []string{}, data["jwt_validation_pubkeys"])
+	assert.Equal(t, []string{}, data["jwt_supported_algs"])
+}
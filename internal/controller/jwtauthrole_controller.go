@@ -0,0 +1,202 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	jwtAuthRoleFinalizer = "jwtauthrole.finalizers.ops.community.dev"
+)
+
+// JWTAuthRoleReconciler reconciles a JWTAuthRole object
+type JWTAuthRoleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=jwtauthroles/finalizers,verbs=update
+
+// Reconcile writes the role into the jwt/oidc auth method referenced by the
+// JWTAuthRole and removes it from Vault when the object is deleted.
+func (r *JWTAuthRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting JWT Auth Role Reconciliation")
+
+	obj := &v1alpha1.JWTAuthRole{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod, "jwt", "oidc")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the auth method is already gone and its roles with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, authMethod.Spec.VaultServer.Name, authMethod.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	jwtAuthOp := cvault.NewJWTAuthOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, jwtAuthOp, authMethod.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, jwtAuthRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, jwtAuthRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = jwtAuthOp.CreateOrUpdateRole(ctx, authMethod.Spec.Path, obj.Spec.Name, jwtAuthRoleData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update jwt role: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"JWT auth role synchronized successfully", defaultRequeueTime)
+}
+
+// jwtAuthRoleData sends every managed parameter, so settings removed from the
+// spec are reset in Vault.
+func jwtAuthRoleData(spec v1alpha1.JWTAuthRoleSpec) map[string]interface{} {
+	roleType := spec.RoleType
+	if roleType == "" {
+		roleType = "jwt"
+	}
+	userClaim := spec.UserClaim
+	if userClaim == "" {
+		userClaim = "sub"
+	}
+	boundClaimsType := spec.BoundClaimsType
+	if boundClaimsType == "" {
+		boundClaimsType = "string"
+	}
+
+	boundClaims := make(map[string]interface{}, len(spec.BoundClaims))
+	for claim, values := range spec.BoundClaims {
+		boundClaims[claim] = values
+	}
+
+	claimMappings := make(map[string]interface{}, len(spec.ClaimMappings))
+	for claim, metadata := range spec.ClaimMappings {
+		claimMappings[claim] = metadata
+	}
+
+	data := tokenSettingsData(spec.Policies, spec.TokenSettings)
+	data["role_type"] = roleType
+	data["user_claim"] = userClaim
+	data["groups_claim"] = spec.GroupsClaim
+	data["bound_audiences"] = cvault.NonNilStrings(spec.BoundAudiences)
+	data["bound_subject"] = spec.BoundSubject
+	data["bound_claims"] = boundClaims
+	data["bound_claims_type"] = boundClaimsType
+	data["claim_mappings"] = claimMappings
+	data["allowed_redirect_uris"] = cvault.NonNilStrings(spec.AllowedRedirectURIs)
+	data["oidc_scopes"] = cvault.NonNilStrings(spec.OIDCScopes)
+	return data
+}
+
+func (r *JWTAuthRoleReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.JWTAuthRole, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.JWTAuthRole{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *JWTAuthRoleReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.JWTAuthRole, jwtAuthOp *cvault.JWTAuthOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, jwtAuthRoleFinalizer) {
+		err := jwtAuthOp.DeleteRole(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *JWTAuthRoleReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.JWTAuthRole) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, jwtAuthRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, jwtAuthRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *JWTAuthRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.JWTAuthRole{}, specChanged).
+		Named("jwtauthrole").
+		Complete(r)
+}
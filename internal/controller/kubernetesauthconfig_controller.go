@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KubernetesAuthConfigReconciler reconciles a KubernetesAuthConfig object
+type KubernetesAuthConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthconfigs/finalizers,verbs=update
+
+// Reconcile writes the kubernetes auth method configuration of the referenced
+// AuthMethod. Vault offers no endpoint to delete this configuration, it goes
+// away together with the mount, so no finalizer is needed here.
+func (r *KubernetesAuthConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Kubernetes Auth Config Reconciliation")
+
+	obj := &v1alpha1.KubernetesAuthConfig{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod, "kubernetes")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, authMethod.Spec.VaultServer.Name, authMethod.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	var reviewerJWT string
+	if obj.Spec.TokenReviewerJWTSecretRef != nil {
+		reviewerJWT, err = getSecretKeyValue(ctx, r.Client, req.Namespace, obj.Spec.TokenReviewerJWTSecretRef)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to read token reviewer jwt: %v", err), errorRequeueTime)
+		}
+	}
+
+	k8sAuthOp := cvault.NewKubernetesAuthOperator(vaultOpInstance.Client)
+	err = k8sAuthOp.ConfigureAuth(ctx, authMethod.Spec.Path, kubernetesAuthConfigData(obj.Spec, reviewerJWT), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to configure kubernetes auth method: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Kubernetes auth config synchronized successfully", defaultRequeueTime)
+}
+
+// kubernetesAuthConfigData sends every managed parameter, so settings removed
+// from the spec are reset in Vault.
+func kubernetesAuthConfigData(spec v1alpha1.KubernetesAuthConfigSpec, reviewerJWT string) map[string]interface{} {
+	return map[string]interface{}{
+		"kubernetes_host":        spec.KubernetesHost,
+		"kubernetes_ca_cert":     spec.KubernetesCACert,
+		"token_reviewer_jwt":     reviewerJWT,
+		"issuer":                 spec.Issuer,
+		"disable_iss_validation": spec.DisableIssValidation,
+		"disable_local_ca_jwt":   spec.DisableLocalCAJWT,
+		"pem_keys":               cvault.NonNilStrings(spec.PEMKeys),
+	}
+}
+
+func (r *KubernetesAuthConfigReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.KubernetesAuthConfig, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.KubernetesAuthConfig{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubernetesAuthConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.KubernetesAuthConfig{}, specChanged).
+		Named("kubernetesauthconfig").
+		Complete(r)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	kubernetesAuthRoleFinalizer = "kubernetesauthrole.finalizers.ops.community.dev"
+)
+
+// KubernetesAuthRoleReconciler reconciles a KubernetesAuthRole object
+type KubernetesAuthRoleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=kubernetesauthroles/finalizers,verbs=update
+
+// Reconcile writes the role into the kubernetes auth method referenced by the
+// KubernetesAuthRole and removes it from Vault when the object is deleted.
+func (r *KubernetesAuthRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Kubernetes Auth Role Reconciliation")
+
+	obj := &v1alpha1.KubernetesAuthRole{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod, "kubernetes")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the auth method is already gone and its roles with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, authMethod.Spec.VaultServer.Name, authMethod.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	k8sAuthOp := cvault.NewKubernetesAuthOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, k8sAuthOp, authMethod.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, kubernetesAuthRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, kubernetesAuthRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = k8sAuthOp.CreateOrUpdateRole(ctx, authMethod.Spec.Path, obj.Spec.Name, kubernetesAuthRoleData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update kubernetes role: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"Kubernetes auth role synchronized successfully", defaultRequeueTime)
+}
+
+// kubernetesAuthRoleData sends every managed parameter, so settings removed
+// from the spec are reset in Vault.
+func kubernetesAuthRoleData(spec v1alpha1.KubernetesAuthRoleSpec) map[string]interface{} {
+	aliasNameSource := spec.AliasNameSource
+	if aliasNameSource == "" {
+		aliasNameSource = "serviceaccount_uid"
+	}
+
+	data := tokenSettingsData(spec.Policies, spec.TokenSettings)
+	data["bound_service_account_names"] = cvault.NonNilStrings(spec.BoundServiceAccountNames)
+	data["bound_service_account_namespaces"] = cvault.NonNilStrings(spec.BoundServiceAccountNamespaces)
+	data["audience"] = spec.Audience
+	data["alias_name_source"] = aliasNameSource
+	return data
+}
+
+func (r *KubernetesAuthRoleReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.KubernetesAuthRole, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.KubernetesAuthRole{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *KubernetesAuthRoleReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.KubernetesAuthRole, k8sAuthOp *cvault.KubernetesAuthOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, kubernetesAuthRoleFinalizer) {
+		err := k8sAuthOp.DeleteRole(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *KubernetesAuthRoleReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.KubernetesAuthRole) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, kubernetesAuthRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, kubernetesAuthRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KubernetesAuthRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.KubernetesAuthRole{}, specChanged).
+		Named("kubernetesauthrole").
+		Complete(r)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ldapDefaultUserFilter  = "({{.UserAttr}}={{.Username}})"
+	ldapDefaultGroupFilter = "(|(&(objectClass=group)(member={{.UserDN}}))(&(objectClass=groupOfNames)(member={{.UserDN}}))(&(objectClass=groupOfUniqueNames)(uniqueMember={{.UserDN}})))"
+)
+
+// LDAPAuthConfigReconciler reconciles a LDAPAuthConfig object
+type LDAPAuthConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=ldapauthconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=ldapauthconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=ldapauthconfigs/finalizers,verbs=update
+
+// Reconcile writes the ldap auth method configuration of the referenced
+// AuthMethod. As with the other auth configs, it is removed together with
+// the mount and needs no finalizer.
+func (r *LDAPAuthConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting LDAP Auth Config Reconciliation")
+
+	obj := &v1alpha1.LDAPAuthConfig{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	authMethod, err := getAuthMethod(ctx, r.Client, req.Namespace, obj.Spec.AuthMethod, "ldap")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve auth method: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, authMethod.Spec.VaultServer.Name, authMethod.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	var bindpass string
+	if obj.Spec.BindPassSecretRef != nil {
+		bindpass, err = getSecretKeyValue(ctx, r.Client, req.Namespace, obj.Spec.BindPassSecretRef)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to read bind password: %v", err), errorRequeueTime)
+		}
+	}
+
+	authOp := cvault.NewAuthOperator(vaultOpInstance.Client)
+	err = authOp.ConfigureLDAP(ctx, authMethod.Spec.Path, ldapConfigData(obj.Spec, bindpass), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to configure ldap auth method: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"LDAP auth config synchronized successfully", defaultRequeueTime)
+}
+
+// ldapConfigData sends every managed parameter, so settings removed from the
+// spec are reset in Vault. Attributes, filters and TLS versions fall back to
+// Vault's own defaults, as an empty value is rejected or matches nothing.
+func ldapConfigData(spec v1alpha1.LDAPAuthConfigSpec, bindpass string) map[string]interface{} {
+	data := map[string]interface{}{
+		"url":             spec.URL,
+		"binddn":          spec.BindDN,
+		"bindpass":        bindpass,
+		"userdn":          spec.UserDN,
+		"userattr":        stringOrDefault(spec.UserAttr, "cn"),
+		"userfilter":      stringOrDefault(spec.UserFilter, ldapDefaultUserFilter),
+		"upndomain":       spec.UPNDomain,
+		"discoverdn":      spec.DiscoverDN,
+		"groupdn":         spec.GroupDN,
+		"groupattr":       stringOrDefault(spec.GroupAttr, "cn"),
+		"groupfilter":     stringOrDefault(spec.GroupFilter, ldapDefaultGroupFilter),
+		"starttls":        false,
+		"insecure_tls":    false,
+		"certificate":     "",
+		"tls_min_version": "tls12",
+		"tls_max_version": "tls12",
+	}
+
+	if tls := spec.TLS; tls != nil {
+		data["starttls"] = tls.StartTLS
+		data["insecure_tls"] = tls.InsecureSkipVerify
+		data["certificate"] = tls.CACert
+		data["tls_min_version"] = stringOrDefault(tls.MinVersion, "tls12")
+		data["tls_max_version"] = stringOrDefault(tls.MaxVersion, "tls12")
+	}
+
+	return data
+}
+
+func stringOrDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func (r *LDAPAuthConfigReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.LDAPAuthConfig, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.LDAPAuthConfig{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LDAPAuthConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.LDAPAuthConfig{}, specChanged).
+		Named("ldapauthconfig").
+		Complete(r)
+}
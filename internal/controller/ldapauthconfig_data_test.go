@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestLDAPConfigDataResetsRemovedSettings(t *testing.T) {
+	data := ldapConfigData(v1alpha1.LDAPAuthConfigSpec{
+		URL:    "ldap://openldap:389",
+		UserDN: "ou=users,dc=example,dc=org",
+	}, "")
+
+	assert.Equal(t, "ldap://openldap:389", data["url"])
+	assert.Equal(t, "", data["bindpass"])
+	assert.Equal(t, "", data["upndomain"])
+	assert.Equal(t, ldapDefaultUserFilter, data["userfilter"])
+	assert.Equal(t, ldapDefaultGroupFilter, data["groupfilter"])
+	assert.Equal(t, false, data["starttls"])
+	assert.Equal(t, false, data["insecure_tls"])
+	assert.Equal(t, "", data["certificate"])
+	assert.Equal(t, "tls12", data["tls_min_version"])
+}
+
+func TestLDAPConfigDataTLS(t *testing.T) {
+	data := ldapConfigData(v1alpha1.LDAPAuthConfigSpec{
+		URL: "ldap://openldap:389",
+		TLS: &v1alpha1.LDAPTLSConfig{StartTLS: true, CACert: "pem", MaxVersion: "tls13"},
+	}, "secret")
+
+	assert.Equal(t, "secret", data["bindpass"])
+	assert.Equal(t, true, data["starttls"])
+	assert.Equal(t, "pem", data["certificate"])
+	assert.Equal(t, "tls12", data["tls_min_version"])
+	assert.Equal(t, "tls13", data["tls_max_version"])
+}
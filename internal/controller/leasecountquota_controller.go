@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	leaseCountQuotaFinalizer = "leasecountquota.finalizers.ops.community.dev"
+)
+
+// LeaseCountQuotaReconciler reconciles a LeaseCountQuota object
+type LeaseCountQuotaReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=leasecountquotas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=leasecountquotas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=leasecountquotas/finalizers,verbs=update
+
+// Reconcile keeps sys/quotas/lease-count/<name> in line with the spec, with
+// the same drift detection as RateLimitQuota. Lease count quotas require
+// Vault Enterprise.
+func (r *LeaseCountQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Lease Count Quota Reconciliation")
+
+	obj := &v1alpha1.LeaseCountQuota{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	quotaOp := cvault.NewQuotaOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, quotaOp, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, leaseCountQuotaFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, leaseCountQuotaFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	desired, err := leaseCountQuota(obj.Spec)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	changed, err := quotaOp.EnsureQuota(ctx, cvault.QuotaTypeLeaseCount, obj.Spec.Name, desired, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to write lease count quota: %v", err), errorRequeueTime)
+	}
+
+	if changed && readyAtGeneration(obj.Status.Conditions, obj.Generation) {
+		logger.Info("Lease count quota drifted from spec and was rewritten", "quota", obj.Spec.Name)
+		obj.Status.LastDriftCorrection = &metav1.Time{Time: time.Now()}
+		return r.updateStatus(ctx, obj, true, v1alpha1.ReasonDriftCorrected,
+			"Lease count quota drifted and was corrected", defaultRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true, v1alpha1.ReasonSynchronized,
+		"Lease count quota synchronized successfully", defaultRequeueTime)
+}
+
+func leaseCountQuota(spec v1alpha1.LeaseCountQuotaSpec) (cvault.Quota, error) {
+	if spec.MaxLeases < 1 {
+		return cvault.Quota{}, fmt.Errorf("maxLeases must be positive, got %d", spec.MaxLeases)
+	}
+
+	return cvault.Quota{
+		Path:        spec.Path,
+		Role:        spec.Role,
+		Inheritable: spec.Inheritable,
+		MaxLeases:   spec.MaxLeases,
+	}, nil
+}
+
+func (r *LeaseCountQuotaReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.LeaseCountQuota, synchronized bool, reason string, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.LeaseCountQuota{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.LastDriftCorrection != nil {
+			latest.Status.LastDriftCorrection = obj.Status.LastDriftCorrection
+		}
+		setReadyCondition(&latest.Status.Conditions, obj.Generation, synchronized, reason, message)
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *LeaseCountQuotaReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.LeaseCountQuota, quotaOp *cvault.QuotaOperator, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, leaseCountQuotaFinalizer) {
+		err := quotaOp.DeleteQuota(ctx, cvault.QuotaTypeLeaseCount, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, leaseCountQuotaFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LeaseCountQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.LeaseCountQuota{}, specChanged).
+		Named("leasecountquota").
+		Complete(r)
+}
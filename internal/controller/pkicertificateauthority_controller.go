@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PKICertificateAuthorityReconciler reconciles a PKICertificateAuthority object
+type PKICertificateAuthorityReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkicertificateauthorities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkicertificateauthorities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkicertificateauthorities/finalizers,verbs=update
+
+// Reconcile bootstraps the CA of a pki mount. A root is generated once, an
+// intermediate goes through two steps: the CSR is published in the status,
+// then the signed certificate is imported as soon as it is provided.
+// Issuers are never deleted by the operator, a CA outlives its resource and
+// only goes away with the mount.
+func (r *PKICertificateAuthorityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting PKI Certificate Authority Reconciliation")
+
+	obj := &v1alpha1.PKICertificateAuthority{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "pki")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	pkiOp := cvault.NewPKIOperator(vaultOpInstance.Client)
+	mountPath := secretEngine.Spec.Path
+
+	issuerID, err := pkiOp.FindIssuer(ctx, mountPath, obj.Spec.IssuerName, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to list issuers: %v", err), errorRequeueTime)
+	}
+
+	if issuerID == "" {
+		if obj.Spec.Type == "root" {
+			root, err := pkiOp.GenerateRoot(ctx, mountPath, pkiRootRequest(obj.Spec), vaultOpInstance.Token)
+			if err != nil {
+				return r.updateStatus(ctx, obj, false,
+					fmt.Sprintf("Failed to generate root CA: %v", err), errorRequeueTime)
+			}
+			issuerID = root.IssuerId
+		} else {
+			issuerID, err = r.reconcileIntermediate(ctx, obj, pkiOp, mountPath, vaultOpInstance.Token)
+			if err != nil {
+				return r.updateStatus(ctx, obj, false,
+					fmt.Sprintf("Failed to bootstrap intermediate CA: %v", err), errorRequeueTime)
+			}
+			if issuerID == "" {
+				return r.updateStatus(ctx, obj, false,
+					"Waiting for the CSR in status.csr to be signed and referenced by signedCertificateSecretRef", defaultRequeueTime)
+			}
+		}
+	}
+
+	issuer, err := pkiOp.ReadIssuer(ctx, mountPath, issuerID, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read issuer: %v", err), errorRequeueTime)
+	}
+
+	obj.Status.IssuerID = issuerID
+	obj.Status.Certificate = issuer.Certificate
+	obj.Status.CSR = ""
+	return r.updateStatus(ctx, obj, true,
+		"PKI certificate authority synchronized successfully", defaultRequeueTime)
+}
+
+// reconcileIntermediate generates the CSR on first run and imports the signed
+// certificate once available. It returns the new issuer id, or an empty
+// string while waiting for the signed certificate. The CSR always comes from
+// the key named after the issuer, so losing status.csr only re-derives it.
+func (r *PKICertificateAuthorityReconciler) reconcileIntermediate(ctx context.Context, obj *v1alpha1.PKICertificateAuthority, pkiOp *cvault.PKIOperator, mountPath string, token string) (string, error) {
+	if obj.Status.CSR == "" {
+		csr, err := pkiOp.GenerateIntermediateCSR(ctx, mountPath, pkiIntermediateRequest(obj.Spec), token)
+		if err != nil {
+			return "", err
+		}
+		obj.Status.CSR = csr.Csr
+		return "", nil
+	}
+
+	if obj.Spec.SignedCertificateSecretRef == nil {
+		return "", nil
+	}
+
+	certificate, err := getSecretKeyValue(ctx, r.Client, obj.Namespace, obj.Spec.SignedCertificateSecretRef)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// the key is named after the issuer, see pkiIntermediateRequest
+	return pkiOp.ImportSignedIntermediate(ctx, mountPath, certificate, obj.Spec.IssuerName, obj.Spec.IssuerName, token)
+}
+
+func pkiRootRequest(spec v1alpha1.PKICertificateAuthoritySpec) schema.PkiGenerateRootRequest {
+	return schema.PkiGenerateRootRequest{
+		IssuerName:   spec.IssuerName,
+		KeyName:      spec.IssuerName,
+		CommonName:   spec.CommonName,
+		AltNames:     strings.Join(spec.AltNames, ","),
+		IpSans:       spec.IPSANs,
+		Organization: spec.Organization,
+		Ou:           spec.OU,
+		Country:      spec.Country,
+		KeyType:      spec.KeyType,
+		KeyBits:      spec.KeyBits,
+		Ttl:          spec.TTL,
+	}
+}
+
+func pkiIntermediateRequest(spec v1alpha1.PKICertificateAuthoritySpec) schema.PkiGenerateIntermediateRequest {
+	return schema.PkiGenerateIntermediateRequest{
+		KeyName:      spec.IssuerName,
+		CommonName:   spec.CommonName,
+		AltNames:     strings.Join(spec.AltNames, ","),
+		IpSans:       spec.IPSANs,
+		Organization: spec.Organization,
+		Ou:           spec.OU,
+		Country:      spec.Country,
+		KeyType:      spec.KeyType,
+		KeyBits:      spec.KeyBits,
+	}
+}
+
+// updateStatus also persists the issuer fields held in obj.Status.
+func (r *PKICertificateAuthorityReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.PKICertificateAuthority, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.PKICertificateAuthority{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.IssuerID = obj.Status.IssuerID
+		latest.Status.CSR = obj.Status.CSR
+		latest.Status.Certificate = obj.Status.Certificate
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PKICertificateAuthorityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.PKICertificateAuthority{}, specChanged).
+		Named("pkicertificateauthority").
+		Complete(r)
+}
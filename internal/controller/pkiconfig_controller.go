@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PKIConfigReconciler reconciles a PKIConfig object
+type PKIConfigReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiconfigs/finalizers,verbs=update
+
+// Reconcile writes the URL, CRL and default issuer configuration of the
+// referenced pki SecretEngine. Like the auth configs, it lives as long as the
+// mount and needs no finalizer.
+func (r *PKIConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting PKI Config Reconciliation")
+
+	obj := &v1alpha1.PKIConfig{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "pki")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	pkiOp := cvault.NewPKIOperator(vaultOpInstance.Client)
+	mountPath := secretEngine.Spec.Path
+
+	if urls := obj.Spec.URLs; urls != nil {
+		err = pkiOp.ConfigureURLs(ctx, mountPath, schema.PkiConfigureUrlsRequest{
+			IssuingCertificates:   urls.IssuingCertificates,
+			CrlDistributionPoints: urls.CRLDistributionPoints,
+			OcspServers:           urls.OCSPServers,
+		}, vaultOpInstance.Token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to configure urls: %v", err), errorRequeueTime)
+		}
+	}
+
+	if crl := obj.Spec.CRL; crl != nil {
+		err = pkiOp.ConfigureCRL(ctx, mountPath, schema.PkiConfigureCrlRequest{
+			Expiry:                 crl.Expiry,
+			Disable:                crl.Disable,
+			AutoRebuild:            crl.AutoRebuild,
+			AutoRebuildGracePeriod: crl.AutoRebuildGracePeriod,
+			EnableDelta:            crl.EnableDelta,
+			DeltaRebuildInterval:   crl.DeltaRebuildInterval,
+			OcspDisable:            crl.OCSPDisable,
+		}, vaultOpInstance.Token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to configure crl: %v", err), errorRequeueTime)
+		}
+	}
+
+	if obj.Spec.DefaultIssuer != "" {
+		err = pkiOp.ConfigureIssuers(ctx, mountPath, schema.PkiConfigureIssuersRequest{
+			Default:                    obj.Spec.DefaultIssuer,
+			DefaultFollowsLatestIssuer: obj.Spec.DefaultFollowsLatestIssuer,
+		}, vaultOpInstance.Token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to configure default issuer: %v", err), errorRequeueTime)
+		}
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"PKI config synchronized successfully", defaultRequeueTime)
+}
+
+func (r *PKIConfigReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.PKIConfig, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.PKIConfig{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PKIConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.PKIConfig{}, specChanged).
+		Named("pkiconfig").
+		Complete(r)
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	pkiRoleFinalizer = "pkirole.finalizers.ops.community.dev"
+)
+
+// PKIRoleReconciler reconciles a PKIRole object
+type PKIRoleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=pkiroles/finalizers,verbs=update
+
+// Reconcile writes the role into the pki secret engine referenced by the
+// PKIRole and removes it from Vault when the object is deleted.
+func (r *PKIRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting PKI Role Reconciliation")
+
+	obj := &v1alpha1.PKIRole{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "pki")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its roles with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	pkiOp := cvault.NewPKIOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, pkiOp, secretEngine.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, pkiRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, pkiRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = pkiOp.CreateOrUpdateRole(ctx, secretEngine.Spec.Path, obj.Spec.Name, pkiRoleData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update pki role: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"PKI role synchronized successfully", defaultRequeueTime)
+}
+
+// pkiRoleData builds the raw role request. Booleans defaulting to true in
+// Vault are pointers in the spec and only sent when set.
+func pkiRoleData(spec v1alpha1.PKIRoleSpec) map[string]interface{} {
+	data := map[string]interface{}{
+		"issuer_ref":         spec.IssuerRef,
+		"allowed_domains":    spec.AllowedDomains,
+		"allow_subdomains":   spec.AllowSubdomains,
+		"allow_bare_domains": spec.AllowBareDomains,
+		"allow_glob_domains": spec.AllowGlobDomains,
+		"allow_any_name":     spec.AllowAnyName,
+		"no_store":           spec.NoStore,
+	}
+
+	setIfNotEmpty(data, "key_type", spec.KeyType)
+	setIfNotEmpty(data, "ttl", spec.TTL)
+	setIfNotEmpty(data, "max_ttl", spec.MaxTTL)
+	setIfNotEmpty(data, "key_usage", spec.KeyUsage)
+	setIfNotEmpty(data, "ext_key_usage", spec.ExtKeyUsage)
+	setIfNotEmpty(data, "organization", spec.Organization)
+	setIfNotEmpty(data, "ou", spec.OU)
+
+	if spec.KeyBits != 0 {
+		data["key_bits"] = spec.KeyBits
+	}
+
+	optionalFlags := map[string]*bool{
+		"allow_localhost":             spec.AllowLocalhost,
+		"allow_ip_sans":               spec.AllowIPSANs,
+		"allow_wildcard_certificates": spec.AllowWildcardCertificates,
+		"enforce_hostnames":           spec.EnforceHostnames,
+		"server_flag":                 spec.ServerFlag,
+		"client_flag":                 spec.ClientFlag,
+	}
+	for key, value := range optionalFlags {
+		if value != nil {
+			data[key] = *value
+		}
+	}
+
+	return data
+}
+
+func (r *PKIRoleReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.PKIRole, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.PKIRole{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *PKIRoleReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.PKIRole, pkiOp *cvault.PKIOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, pkiRoleFinalizer) {
+		err := pkiOp.DeleteRole(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *PKIRoleReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.PKIRole) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, pkiRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, pkiRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PKIRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.PKIRole{}, specChanged).
+		Named("pkirole").
+		Complete(r)
+}
@@ -73,7 +73,7 @@ func (r *PolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, 
-		obj.Spec.VaultServer.Namespace, req.Namespace, r.Client)
+		obj.Spec.VaultServer.Namespace, obj, r.Client)
 	if err != nil {
 		return r.updateStatus(ctx, obj, false,
 			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+// getAuthMethod fetches the AuthMethod referenced by ref and checks that it
+// is one of the accepted types.
+func getAuthMethod(ctx context.Context, c client.Client, namespace string, ref v1alpha1.AuthMethodReference, allowedTypes ...string) (*v1alpha1.AuthMethod, error) {
+	authMethod := &v1alpha1.AuthMethod{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, authMethod); err != nil {
+		return nil, fmt.Errorf("failed to get auth method %s: %w", ref.Name, err)
+	}
+
+	if len(allowedTypes) > 0 && !slices.Contains(allowedTypes, authMethod.Spec.Type) {
+		return nil, fmt.Errorf("auth method %s has type %s, expected one of %v", ref.Name, authMethod.Spec.Type, allowedTypes)
+	}
+
+	return authMethod, nil
+}
+
+// getSecretEngine fetches the SecretEngine referenced by ref and checks that
+// it is one of the accepted types.
+func getSecretEngine(ctx context.Context, c client.Client, namespace string, ref v1alpha1.SecretEngineReference, allowedTypes ...string) (*v1alpha1.SecretEngine, error) {
+	secretEngine := &v1alpha1.SecretEngine{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, secretEngine); err != nil {
+		return nil, fmt.Errorf("failed to get secret engine %s: %w", ref.Name, err)
+	}
+
+	if len(allowedTypes) > 0 && !slices.Contains(allowedTypes, secretEngine.Spec.Type) {
+		return nil, fmt.Errorf("secret engine %s has type %s, expected one of %v", ref.Name, secretEngine.Spec.Type, allowedTypes)
+	}
+
+	return secretEngine, nil
+}
+
+// getSecretKeyValue reads a single key from a Kubernetes Secret.
+func getSecretKeyValue(ctx context.Context, c client.Client, namespace string, ref *v1alpha1.SecretKeyReference) (string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+	}
+
+	return string(value), nil
+}
+
+// getPKIRole fetches the PKIRole referenced by ref.
+func getPKIRole(ctx context.Context, c client.Client, namespace string, ref v1alpha1.PKIRoleReference) (*v1alpha1.PKIRole, error) {
+	pkiRole := &v1alpha1.PKIRole{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, pkiRole); err != nil {
+		return nil, fmt.Errorf("failed to get pki role %s: %w", ref.Name, err)
+	}
+
+	return pkiRole, nil
+}
+
+// getDatabaseConnection fetches the DatabaseConnection referenced by ref.
+func getDatabaseConnection(ctx context.Context, c client.Client, namespace string, ref v1alpha1.DatabaseConnectionReference) (*v1alpha1.DatabaseConnection, error) {
+	connection := &v1alpha1.DatabaseConnection{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, connection); err != nil {
+		return nil, fmt.Errorf("failed to get database connection %s: %w", ref.Name, err)
+	}
+
+	return connection, nil
+}
+
+// getIdentityEntity fetches the IdentityEntity referenced by ref.
+func getIdentityEntity(ctx context.Context, c client.Client, namespace string, ref v1alpha1.IdentityEntityReference) (*v1alpha1.IdentityEntity, error) {
+	entity := &v1alpha1.IdentityEntity{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, entity); err != nil {
+		return nil, fmt.Errorf("failed to get identity entity %s: %w", ref.Name, err)
+	}
+
+	return entity, nil
+}
+
+// getIdentityGroup fetches the IdentityGroup referenced by ref.
+func getIdentityGroup(ctx context.Context, c client.Client, namespace string, ref v1alpha1.IdentityGroupReference) (*v1alpha1.IdentityGroup, error) {
+	group := &v1alpha1.IdentityGroup{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, group); err != nil {
+		return nil, fmt.Errorf("failed to get identity group %s: %w", ref.Name, err)
+	}
+
+	return group, nil
+}
+
+// checkControlled refuses to write to an existing object that is not
+// controlled by owner, so Secrets and ConfigMaps created by someone else are
+// never taken over. Objects not created yet pass.
+func checkControlled(obj client.Object, owner metav1.Object) error {
+	if obj.GetResourceVersion() == "" || metav1.IsControlledBy(obj, owner) {
+		return nil
+	}
+	return fmt.Errorf("%s/%s already exists and is not controlled by %s", obj.GetNamespace(), obj.GetName(), owner.GetName())
+}
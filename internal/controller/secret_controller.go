@@ -70,7 +70,7 @@ func (r *SecretReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	vaultOpInstance, err := getVaultOpClient(ctx, secret.Spec.VaultServer.Name, secret.Spec.VaultServer.Namespace, req.Namespace, r.Client)
+	vaultOpInstance, err := getVaultOpClient(ctx, secret.Spec.VaultServer.Name, secret.Spec.VaultServer.Namespace, secret, r.Client)
 	if err != nil {
 		return r.updateSecretStatus(ctx, secret, false,
 			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
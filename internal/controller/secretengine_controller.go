@@ -18,10 +18,12 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/vault-client-go/schema"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -68,9 +70,9 @@ func (r *SecretEngineReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, req.Namespace, r.Client)
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
 	if err != nil {
-		return r.updateStatus(ctx, obj, false,
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
 	}
 
@@ -83,24 +85,210 @@ func (r *SecretEngineReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		patch := client.MergeFrom(obj.DeepCopy())
 		controllerutil.AddFinalizer(obj, secretEngineFinalizer)
 		if err := r.Patch(ctx, obj, patch); err != nil {
-			return r.updateStatus(ctx, obj, false,
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
 		}
 
 		return ctrl.Result{RequeueAfter: 0}, nil
 	}
 
-	err = secretEngOp.EnableMount(obj.Spec.Path, obj.Spec.Type, vaultOpInstance.Token)
+	if obj.Status.MountedPath != "" && obj.Status.MountedPath != obj.Spec.Path {
+		return r.movePath(ctx, obj, secretEngOp, vaultOpInstance.Token)
+	}
+
+	err = secretEngOp.EnableMountWithConfig(ctx, obj.Spec.Path, secretEngineEnableRequest(obj.Spec), vaultOpInstance.Token)
+	var mismatch *cvault.MountTypeMismatchError
+	if errors.As(err, &mismatch) {
+		// someone else owns this path, never touch it
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonTypeMismatch,
+			fmt.Sprintf("Blocked: %v", err), defaultRequeueTime)
+	}
 	if err != nil {
-		return r.updateStatus(ctx, obj, false,
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
 			fmt.Sprintf("Failed to enable secret engine: %v", err), errorRequeueTime)
 	}
 
-	return r.updateStatus(ctx, obj, true,
+	// tune on every reconcile so changes and drift made outside the operator are corrected
+	err = secretEngOp.TuneMount(ctx, obj.Spec.Path, secretEngineTuneData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to tune secret engine: %v", err), errorRequeueTime)
+	}
+
+	obj.Status.MountedPath = obj.Spec.Path
+	return r.updateStatus(ctx, obj, true, v1alpha1.ReasonSynchronized,
 		"Secret engine synchronized successfully", defaultRequeueTime)
 }
 
-func (r *SecretEngineReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.SecretEngine, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+// movePath applies a change of spec.path to the mount living at
+// status.mountedPath. Remounts run asynchronously in Vault, the migration is
+// followed through status.migrationId and status.migrationStatus. A remount
+// Vault reports as failed is final for the generation it was started for, so a
+// broken move is not retried in a loop; errors reaching Vault are retried.
+func (r *SecretEngineReconciler) movePath(ctx context.Context, obj *v1alpha1.SecretEngine, secretEngOp *cvault.SecretEngineOperator, token string) (ctrl.Result, error) {
+	from, to := obj.Status.MountedPath, obj.Spec.Path
+
+	if obj.Status.MigrationStatus == cvault.MigrationFailure {
+		if obj.Status.MigrationGeneration == obj.Generation {
+			return ctrl.Result{}, nil
+		}
+
+		// the spec changed since the failure, try again
+		obj.Status.MigrationID = ""
+		obj.Status.MigrationStatus = ""
+	}
+
+	if obj.Status.MigrationID != "" {
+		status, err := secretEngOp.RemountStatus(ctx, obj.Status.MigrationID, token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+				fmt.Sprintf("Failed to read remount status: %v", err), errorRequeueTime)
+		}
+		obj.Status.MigrationStatus = status
+
+		switch status {
+		case cvault.MigrationSuccess:
+			obj.Status.MountedPath = to
+			obj.Status.MigrationID = ""
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+				fmt.Sprintf("Secret engine remounted from %s to %s", from, to), 0)
+		case cvault.MigrationFailure:
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemountFailed,
+				fmt.Sprintf("Remount from %s to %s failed (migration %s), update the spec to retry", from, to, obj.Status.MigrationID), 0)
+		default:
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+				fmt.Sprintf("Remount from %s to %s in progress", from, to), remountPollTime)
+		}
+	}
+
+	_, fromMounted, err := secretEngOp.MountType(from, token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to list mounts: %v", err), errorRequeueTime)
+	}
+	if !fromMounted {
+		// nothing left at the old path, start over at the new one
+		obj.Status.MountedPath = ""
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+			fmt.Sprintf("Secret engine not found at %s, enabling it at %s", from, to), 0)
+	}
+
+	_, toMounted, err := secretEngOp.MountType(to, token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to list mounts: %v", err), errorRequeueTime)
+	}
+	if toMounted {
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonPathConflict,
+			fmt.Sprintf("Blocked: cannot move secret engine from %s, path %s is already in use", from, to), defaultRequeueTime)
+	}
+
+	if obj.Spec.PathChangePolicy == v1alpha1.PathChangeDisable {
+		if err := secretEngOp.DisableMount(from, token); err != nil {
+			return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+				fmt.Sprintf("Failed to disable secret engine at %s: %v", from, err), errorRequeueTime)
+		}
+
+		obj.Status.MountedPath = ""
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+			fmt.Sprintf("Secret engine disabled at %s, enabling it at %s", from, to), 0)
+	}
+
+	migrationID, err := secretEngOp.RemountMount(ctx, from, to, token)
+	if err != nil {
+		// the remount may not have reached Vault, only a failure reported by
+		// the remount status is final
+		return r.updateStatus(ctx, obj, false, v1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to remount secret engine from %s to %s: %v", from, to, err), errorRequeueTime)
+	}
+
+	obj.Status.MigrationID = migrationID
+	obj.Status.MigrationGeneration = obj.Generation
+	obj.Status.MigrationStatus = cvault.MigrationInProgress
+	return r.updateStatus(ctx, obj, false, v1alpha1.ReasonRemounting,
+		fmt.Sprintf("Remount from %s to %s started", from, to), remountPollTime)
+}
+
+func secretEngineEnableRequest(spec v1alpha1.SecretEngineSpec) schema.MountsEnableSecretsEngineRequest {
+	request := schema.MountsEnableSecretsEngineRequest{
+		Type:    spec.Type,
+		Options: secretEngineOptions(spec.Options),
+	}
+
+	if c := spec.Config; c != nil {
+		request.Description = c.Description
+		request.SealWrap = c.SealWrap
+
+		config := map[string]interface{}{}
+		setIfNotEmpty(config, "default_lease_ttl", c.DefaultLeaseTTL)
+		setIfNotEmpty(config, "max_lease_ttl", c.MaxLeaseTTL)
+		setIfNotEmpty(config, "listing_visibility", c.ListingVisibility)
+		setIfNotEmpty(config, "audit_non_hmac_request_keys", c.AuditNonHMACRequestKeys)
+		setIfNotEmpty(config, "audit_non_hmac_response_keys", c.AuditNonHMACResponseKeys)
+		setIfNotEmpty(config, "passthrough_request_headers", c.PassthroughRequestHeaders)
+		setIfNotEmpty(config, "allowed_response_headers", c.AllowedResponseHeaders)
+		if len(config) > 0 {
+			request.Config = config
+		}
+	}
+
+	return request
+}
+
+// secretEngineTuneData sends every managed tune parameter, so settings removed
+// from the spec are reset. Vault reads an empty TTL as "unchanged", "system"
+// resets it to the system default.
+func secretEngineTuneData(spec v1alpha1.SecretEngineSpec) map[string]interface{} {
+	c := spec.Config
+	if c == nil {
+		c = &v1alpha1.SecretEngineConfig{}
+	}
+
+	data := map[string]interface{}{
+		"description":                  c.Description,
+		"default_lease_ttl":            ttlOrSystem(c.DefaultLeaseTTL),
+		"max_lease_ttl":                ttlOrSystem(c.MaxLeaseTTL),
+		"listing_visibility":           c.ListingVisibility,
+		"audit_non_hmac_request_keys":  cvault.NonNilStrings(c.AuditNonHMACRequestKeys),
+		"audit_non_hmac_response_keys": cvault.NonNilStrings(c.AuditNonHMACResponseKeys),
+		"passthrough_request_headers":  cvault.NonNilStrings(c.PassthroughRequestHeaders),
+		"allowed_response_headers":     cvault.NonNilStrings(c.AllowedResponseHeaders),
+	}
+	if options := secretEngineOptions(spec.Options); options != nil {
+		data["options"] = options
+	}
+
+	return data
+}
+
+func ttlOrSystem(ttl string) string {
+	if ttl == "" {
+		return "system"
+	}
+	return ttl
+}
+
+func secretEngineOptions(options map[string]string) map[string]interface{} {
+	if len(options) == 0 {
+		return nil
+	}
+
+	out := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		out[k] = v
+	}
+	return out
+}
+
+func setIfNotEmpty[T string | []string](m map[string]interface{}, key string, value T) {
+	if len(value) > 0 {
+		m[key] = value
+	}
+}
+
+// updateStatus also persists the mount tracking fields held in obj.Status and
+// mirrors the outcome into the Ready condition.
+func (r *SecretEngineReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.SecretEngine, synchronized bool, reason string, message string, requeueAfter time.Duration) (ctrl.Result, error) {
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		latest := &v1alpha1.SecretEngine{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
@@ -110,6 +298,11 @@ func (r *SecretEngineReconciler) updateStatus(ctx context.Context, obj *vaultv1a
 		latest.Status.Message = message
 		latest.Status.Synchronized = strconv.FormatBool(synchronized)
 		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.MountedPath = obj.Status.MountedPath
+		latest.Status.MigrationID = obj.Status.MigrationID
+		latest.Status.MigrationStatus = obj.Status.MigrationStatus
+		latest.Status.MigrationGeneration = obj.Status.MigrationGeneration
+		setReadyCondition(&latest.Status.Conditions, latest.Generation, synchronized, reason, message)
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -128,10 +321,24 @@ func (r *SecretEngineReconciler) updateStatus(ctx context.Context, obj *vaultv1a
 func (r *SecretEngineReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.SecretEngine, vaultSecretEngOp *cvault.SecretEngineOperator, token string) (ctrl.Result, error) {
 	if controllerutil.ContainsFinalizer(obj, secretEngineFinalizer) {
 		// Our finalizer is present, so lets handle any external dependency
-		err := vaultSecretEngOp.DisableMount(obj.Spec.Path, token)
-		if err != nil {
-			// requeue on error for proper cleaning
-			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		path := obj.Status.MountedPath
+		if path == "" {
+			// never mounted by us, only clean up if it is not someone else's mount
+			mType, ok, err := vaultSecretEngOp.MountType(obj.Spec.Path, token)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
+			if ok && mType == cvault.NormalizeMountType(obj.Spec.Type) {
+				path = obj.Spec.Path
+			}
+		}
+
+		if path != "" {
+			err := vaultSecretEngOp.DisableMount(path, token)
+			if err != nil {
+				// requeue on error for proper cleaning
+				return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+			}
 		}
 
 		patch := client.MergeFrom(obj.DeepCopy())
@@ -0,0 +1,134 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+func TestSecretEngineTuneData(t *testing.T) {
+	data := secretEngineTuneData(v1alpha1.SecretEngineSpec{Type: "kv"})
+
+	assert.Equal(t, "", data["description"])
+	assert.Equal(t, "system", data["default_lease_ttl"])
+	assert.Equal(t, "system", data["max_lease_ttl"])
+	assert.Equal(t, "", data["listing_visibility"])
+	assert.Equal(t, []string{}, data["allowed_response_headers"])
+	assert.NotContains(t, data, "options")
+
+	data = secretEngineTuneData(v1alpha1.SecretEngineSpec{
+		Type:    "kv",
+		Options: map[string]string{"version": "2"},
+		Config:  &v1alpha1.SecretEngineConfig{DefaultLeaseTTL: "1h"},
+	})
+	assert.Equal(t, "1h", data["default_lease_ttl"])
+	assert.Equal(t, map[string]interface{}{"version": "2"}, data["options"])
+}
+
+func movingSecretEngine(status v1alpha1.SecretEngineStatus) *v1alpha1.SecretEngine {
+	status.MountedPath = "old"
+	return &v1alpha1.SecretEngine{
+		ObjectMeta: metav1.ObjectMeta{Name: "kv", Namespace: "default", Generation: 2},
+		Spec:       v1alpha1.SecretEngineSpec{Type: "kv", Path: "new"},
+		Status:     status,
+	}
+}
+
+func TestSecretEngineMovePathKeepsFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	obj := movingSecretEngine(v1alpha1.SecretEngineStatus{
+		MigrationID:         "migration-1",
+		MigrationStatus:     cvault.MigrationInProgress,
+		MigrationGeneration: 2,
+	})
+	r := &SecretEngineReconciler{Client: newFakeClient(t, obj)}
+	vc := &fakeVaultClient{migrationStatus: cvault.MigrationFailure}
+	op := cvault.NewSecretEngineOperator(vc)
+
+	_, err := r.movePath(ctx, obj, op, "token")
+	require.NoError(t, err)
+
+	latest := &v1alpha1.SecretEngine{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(obj), latest))
+	assert.Equal(t, "migration-1", latest.Status.MigrationID)
+	assert.Equal(t, cvault.MigrationFailure, latest.Status.MigrationStatus)
+	assert.Equal(t, "old", latest.Status.MountedPath)
+
+	// the next reconcile of the same generation neither polls nor remounts
+	result, err := r.movePath(ctx, latest, op, "token")
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Empty(t, vc.remounts)
+}
+
+func TestSecretEngineMovePathRetriesAfterSpecChange(t *testing.T) {
+	ctx := context.Background()
+	obj := movingSecretEngine(v1alpha1.SecretEngineStatus{
+		MigrationID:         "migration-1",
+		MigrationStatus:     cvault.MigrationFailure,
+		MigrationGeneration: 1,
+	})
+	r := &SecretEngineReconciler{Client: newFakeClient(t, obj)}
+	vc := &fakeVaultClient{mounts: map[string]interface{}{"old/": map[string]interface{}{"type": "kv"}}}
+
+	_, err := r.movePath(ctx, obj, cvault.NewSecretEngineOperator(vc), "token")
+	require.NoError(t, err)
+	require.Len(t, vc.remounts, 1)
+	assert.Equal(t, "new", vc.remounts[0].To)
+
+	latest := &v1alpha1.SecretEngine{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(obj), latest))
+	assert.Equal(t, "migration-2", latest.Status.MigrationID)
+	assert.Equal(t, cvault.MigrationInProgress, latest.Status.MigrationStatus)
+	assert.Equal(t, int64(2), latest.Status.MigrationGeneration)
+}
+
+func TestSecretEngineMovePathRequeuesAfterRemountError(t *testing.T) {
+	ctx := context.Background()
+	obj := movingSecretEngine(v1alpha1.SecretEngineStatus{})
+	r := &SecretEngineReconciler{Client: newFakeClient(t, obj)}
+	vc := &fakeVaultClient{
+		mounts:     map[string]interface{}{"old/": map[string]interface{}{"type": "kv"}},
+		remountErr: errors.New("connection refused"),
+	}
+	op := cvault.NewSecretEngineOperator(vc)
+
+	result, err := r.movePath(ctx, obj, op, "token")
+	require.NoError(t, err)
+	assert.Equal(t, errorRequeueTime, result.RequeueAfter)
+
+	latest := &v1alpha1.SecretEngine{}
+	require.NoError(t, r.Get(ctx, client.ObjectKeyFromObject(obj), latest))
+	assert.Empty(t, latest.Status.MigrationStatus)
+	assert.Empty(t, latest.Status.MigrationID)
+
+	// the same generation tries the remount again
+	_, err = r.movePath(ctx, latest, op, "token")
+	require.NoError(t, err)
+	assert.Len(t, vc.remounts, 2)
+}
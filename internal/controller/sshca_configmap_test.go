@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestSSHCAPublishPublicKeyRefusesForeignConfigMap(t *testing.T) {
+	ctx := context.Background()
+	obj := &v1alpha1.SSHCA{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-ca", Namespace: "default", UID: "uid-1"},
+		Spec:       v1alpha1.SSHCASpec{ConfigMapName: "trusted", ConfigMapKey: "trusted-user-ca-keys.pem"},
+	}
+	foreign := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "trusted", Namespace: "default"},
+		Data:       map[string]string{"trusted-user-ca-keys.pem": "keep-me"},
+	}
+	c := newFakeClient(t, obj, foreign)
+	r := &SSHCAReconciler{Client: c, Scheme: c.Scheme()}
+
+	assert.Error(t, r.publishPublicKey(ctx, obj, "ssh-ed25519 AAAA"))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "trusted", Namespace: "default"}, configMap))
+	assert.Equal(t, "keep-me", configMap.Data["trusted-user-ca-keys.pem"])
+
+	obj.Spec.ConfigMapName = ""
+	require.NoError(t, r.publishPublicKey(ctx, obj, "ssh-ed25519 AAAA"))
+	require.NoError(t, r.publishPublicKey(ctx, obj, "ssh-ed25519 BBBB\n"))
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "ssh-ca", Namespace: "default"}, configMap))
+	assert.Equal(t, "ssh-ed25519 BBBB\n", configMap.Data["trusted-user-ca-keys.pem"])
+}
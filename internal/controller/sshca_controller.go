@@ -0,0 +1,207 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SSHCAReconciler reconciles a SSHCA object
+type SSHCAReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshcas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshcas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshcas/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile generates or imports the signing key of the ssh secret engine
+// referenced by the SSHCA and publishes its public key into a ConfigMap. As
+// for PKI CAs, the signing key outlives the object: deleting it would make
+// every issued certificate untrusted, so no finalizer is needed here.
+func (r *SSHCAReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting SSH CA Reconciliation")
+
+	obj := &v1alpha1.SSHCA{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "ssh")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	sshOp := cvault.NewSSHOperator(vaultOpInstance.Client)
+	mountPath := secretEngine.Spec.Path
+	current, err := sshOp.ReadCAPublicKey(ctx, mountPath, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read ssh CA: %v", err), errorRequeueTime)
+	}
+
+	if obj.Spec.KeyPairSecretRef != nil {
+		if err := r.importKeyPair(ctx, obj, sshOp, mountPath, current, vaultOpInstance.Token); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to import ssh CA key pair: %v", err), errorRequeueTime)
+		}
+	} else if current == "" {
+		err = sshOp.ConfigureCA(ctx, mountPath, schema.SshConfigureCaRequest{
+			GenerateSigningKey: true,
+			KeyType:            obj.Spec.KeyType,
+			KeyBits:            obj.Spec.KeyBits,
+		}, vaultOpInstance.Token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to generate ssh CA signing key: %v", err), errorRequeueTime)
+		}
+	}
+
+	publicKey, err := sshOp.ReadCAPublicKey(ctx, mountPath, vaultOpInstance.Token)
+	if err != nil || publicKey == "" {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read back ssh CA public key: %v", err), errorRequeueTime)
+	}
+	obj.Status.PublicKey = publicKey
+
+	if err := r.publishPublicKey(ctx, obj, publicKey); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to publish public key: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"SSH CA synchronized successfully", defaultRequeueTime)
+}
+
+// importKeyPair imports the key pair of the Secret unless Vault already holds
+// it. Vault cannot overwrite a signing key, so a different one is deleted first.
+func (r *SSHCAReconciler) importKeyPair(ctx context.Context, obj *v1alpha1.SSHCA, sshOp *cvault.SSHOperator, mountPath string, current string, token string) error {
+	ref := obj.Spec.KeyPairSecretRef
+	privateKey, err := getSecretKeyValue(ctx, r.Client, obj.Namespace, &v1alpha1.SecretKeyReference{Name: ref.Name, Key: ref.PrivateKeyKey})
+	if err != nil {
+		return err
+	}
+	publicKey, err := getSecretKeyValue(ctx, r.Client, obj.Namespace, &v1alpha1.SecretKeyReference{Name: ref.Name, Key: ref.PublicKeyKey})
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(current) == strings.TrimSpace(publicKey) {
+		return nil
+	}
+
+	if current != "" {
+		if err := sshOp.DeleteCA(ctx, mountPath, token); err != nil {
+			return err
+		}
+	}
+
+	return sshOp.ConfigureCA(ctx, mountPath, schema.SshConfigureCaRequest{
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}, token)
+}
+
+func (r *SSHCAReconciler) publishPublicKey(ctx context.Context, obj *v1alpha1.SSHCA, publicKey string) error {
+	name := obj.Spec.ConfigMapName
+	if name == "" {
+		name = obj.Name
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: obj.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if err := checkControlled(configMap, obj); err != nil {
+			return err
+		}
+
+		configMap.Data = map[string]string{
+			obj.Spec.ConfigMapKey: strings.TrimSpace(publicKey) + "\n",
+		}
+
+		return controllerutil.SetControllerReference(obj, configMap, r.Scheme)
+	})
+
+	return err
+}
+
+func (r *SSHCAReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.SSHCA, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.SSHCA{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.PublicKey = obj.Status.PublicKey
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SSHCAReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.SSHCA{}, specChanged).
+		Owns(&corev1.ConfigMap{}).
+		Named("sshca").
+		Complete(r)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	sshRoleFinalizer = "sshrole.finalizers.ops.community.dev"
+)
+
+// SSHRoleReconciler reconciles a SSHRole object
+type SSHRoleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=sshroles/finalizers,verbs=update
+
+// Reconcile writes the role into the ssh secret engine referenced by the
+// SSHRole and removes it from Vault when the object is deleted.
+func (r *SSHRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting SSH Role Reconciliation")
+
+	obj := &v1alpha1.SSHRole{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "ssh")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its roles with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	sshOp := cvault.NewSSHOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, sshOp, secretEngine.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, sshRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, sshRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	err = sshOp.CreateOrUpdateRole(ctx, secretEngine.Spec.Path, obj.Spec.Name, sshRoleData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create/update ssh role: %v", err), errorRequeueTime)
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"SSH role synchronized successfully", defaultRequeueTime)
+}
+
+// sshRoleData sends every managed parameter, so settings removed from the spec
+// are reset in Vault.
+func sshRoleData(spec v1alpha1.SSHRoleSpec) map[string]interface{} {
+	keyType := spec.KeyType
+	if keyType == "" {
+		keyType = "ca"
+	}
+	algorithmSigner := spec.AlgorithmSigner
+	if algorithmSigner == "" {
+		algorithmSigner = "default"
+	}
+	port := spec.Port
+	if port == 0 {
+		port = 22
+	}
+
+	defaultExtensions := make(map[string]interface{}, len(spec.DefaultExtensions))
+	for name, value := range spec.DefaultExtensions {
+		defaultExtensions[name] = value
+	}
+
+	defaultCriticalOptions := make(map[string]interface{}, len(spec.DefaultCriticalOptions))
+	for name, value := range spec.DefaultCriticalOptions {
+		defaultCriticalOptions[name] = value
+	}
+
+	return map[string]interface{}{
+		"key_type":                 keyType,
+		"allowed_users":            strings.Join(spec.AllowedUsers, ","),
+		"default_user":             spec.DefaultUser,
+		"allow_user_certificates":  spec.AllowUserCertificates,
+		"allow_host_certificates":  spec.AllowHostCertificates,
+		"allowed_domains":          strings.Join(spec.AllowedDomains, ","),
+		"allow_subdomains":         spec.AllowSubdomains,
+		"allow_bare_domains":       spec.AllowBareDomains,
+		"allow_user_key_ids":       spec.AllowUserKeyIDs,
+		"key_id_format":            spec.KeyIDFormat,
+		"allowed_extensions":       strings.Join(spec.AllowedExtensions, ","),
+		"default_extensions":       defaultExtensions,
+		"allowed_critical_options": strings.Join(spec.AllowedCriticalOptions, ","),
+		"default_critical_options": defaultCriticalOptions,
+		"algorithm_signer":         algorithmSigner,
+		"ttl":                      spec.TTL,
+		"max_ttl":                  spec.MaxTTL,
+		"cidr_list":                strings.Join(spec.CIDRList, ","),
+		"exclude_cidr_list":        strings.Join(spec.ExcludeCIDRList, ","),
+		"port":                     port,
+	}
+}
+
+func (r *SSHRoleReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.SSHRole, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.SSHRole{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *SSHRoleReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.SSHRole, sshOp *cvault.SSHOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, sshRoleFinalizer) {
+		err := sshOp.DeleteRole(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *SSHRoleReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.SSHRole) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, sshRoleFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, sshRoleFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SSHRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.SSHRole{}, specChanged).
+		Named("sshrole").
+		Complete(r)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestSSHRoleDataResetsRemovedSettings(t *testing.T) {
+	data := sshRoleData(v1alpha1.SSHRoleSpec{
+		AllowedUsers: []string{"ubuntu", "admin"},
+	})
+
+	assert.Equal(t, "ca", data["key_type"])
+	assert.Equal(t, "ubuntu,admin", data["allowed_users"])
+	assert.Equal(t, false, data["allow_user_certificates"])
+	assert.Equal(t, "", data["allowed_extensions"])
+	assert.Equal(t, map[string]interface{}{}, data["default_extensions"])
+	assert.Equal(t, "default", data["algorithm_signer"])
+	assert.Equal(t, "", data["ttl"])
+	assert.Equal(t, int32(22), data["port"])
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+// tokenSettingsData returns every token_* parameter of an auth role, zero
+// values included. Typed requests omit empty fields, so a setting removed from
+// the spec would otherwise be kept by Vault.
+func tokenSettingsData(policies []string, ts *v1alpha1.TokenSettings) map[string]interface{} {
+	if ts == nil {
+		ts = &v1alpha1.TokenSettings{}
+	}
+
+	tokenType := ts.Type
+	if tokenType == "" {
+		tokenType = "default"
+	}
+
+	return map[string]interface{}{
+		"token_policies":          cvault.NonNilStrings(policies),
+		"token_ttl":               ts.TTL,
+		"token_max_ttl":           ts.MaxTTL,
+		"token_explicit_max_ttl":  ts.ExplicitMaxTTL,
+		"token_period":            ts.Period,
+		"token_bound_cidrs":       cvault.NonNilStrings(ts.BoundCIDRs),
+		"token_num_uses":          ts.NumUses,
+		"token_type":              tokenType,
+		"token_no_default_policy": ts.NoDefaultPolicy,
+	}
+}
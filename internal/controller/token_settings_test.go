@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestTokenSettingsDataSendsZeroValues(t *testing.T) {
+	data := tokenSettingsData(nil, nil)
+
+	assert.Equal(t, []string{}, data["token_policies"])
+	assert.Equal(t, []string{}, data["token_bound_cidrs"])
+	assert.Equal(t, "", data["token_ttl"])
+	assert.Equal(t, int32(0), data["token_num_uses"])
+	assert.Equal(t, false, data["token_no_default_policy"])
+	assert.Equal(t, "default", data["token_type"])
+}
+
+func TestKubernetesAuthRoleData(t *testing.T) {
+	data := kubernetesAuthRoleData(v1alpha1.KubernetesAuthRoleSpec{
+		BoundServiceAccountNames: []string{"my-app"},
+		Policies:                 []string{"read"},
+		TokenSettings:            &v1alpha1.TokenSettings{TTL: "1h", NoDefaultPolicy: true},
+	})
+
+	assert.Equal(t, []string{"my-app"}, data["bound_service_account_names"])
+	assert.Equal(t, []string{}, data["bound_service_account_namespaces"])
+	assert.Equal(t, "", data["audience"])
+	assert.Equal(t, "serviceaccount_uid", data["alias_name_source"])
+	assert.Equal(t, []string{"read"}, data["token_policies"])
+	assert.Equal(t, "1h", data["token_ttl"])
+	assert.Equal(t, true, data["token_no_default_policy"])
+}
+
+func TestJWTAuthRoleData(t *testing.T) {
+	data := jwtAuthRoleData(v1alpha1.JWTAuthRoleSpec{
+		BoundClaims: map[string][]string{"team": {"platform"}},
+	})
+
+	assert.Equal(t, "jwt", data["role_type"])
+	assert.Equal(t, "sub", data["user_claim"])
+	assert.Equal(t, "string", data["bound_claims_type"])
+	assert.Equal(t, map[string]interface{}{"team": []string{"platform"}}, data["bound_claims"])
+	assert.Equal(t, map[string]interface{}{}, data["claim_mappings"])
+	assert.Equal(t, []string{}, data["bound_audiences"])
+	assert.Equal(t, "", data["token_ttl"])
+}
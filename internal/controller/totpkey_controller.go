@@ -0,0 +1,260 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	totpKeyFinalizer = "totpkey.finalizers.ops.community.dev"
+)
+
+// TOTPKeyReconciler reconciles a TOTPKey object
+type TOTPKeyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=totpkeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=totpkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=totpkeys/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates the key in the totp secret engine referenced by the
+// TOTPKey when it is missing and removes it from Vault when the object is
+// deleted. TOTP keys cannot be updated in place, spec changes after creation
+// are not applied.
+func (r *TOTPKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting TOTP Key Reconciliation")
+
+	obj := &v1alpha1.TOTPKey{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "totp")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its keys with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	totpOp := cvault.NewTOTPOperator(vaultOpInstance.Client)
+	mountPath := secretEngine.Spec.Path
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, totpOp, mountPath, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, totpKeyFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, totpKeyFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	created, err := totpOp.IsKeyCreated(ctx, mountPath, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read totp key: %v", err), errorRequeueTime)
+	}
+	if created {
+		return r.updateStatus(ctx, obj, true,
+			"TOTP key synchronized successfully", defaultRequeueTime)
+	}
+
+	request, err := r.totpKeyRequest(ctx, obj)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Invalid spec: %v", err), errorRequeueTime)
+	}
+
+	export, err := totpOp.CreateKey(ctx, mountPath, obj.Spec.Name, request, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to create totp key: %v", err), errorRequeueTime)
+	}
+
+	if request.Exported {
+		if err := r.writeExportSecret(ctx, obj, export); err != nil {
+			// the barcode cannot be fetched again, drop the key so that the
+			// next reconcile generates and exports a new one
+			if delErr := totpOp.DeleteKey(ctx, mountPath, obj.Spec.Name, vaultOpInstance.Token); delErr != nil {
+				logger.Error(delErr, "Failed to delete unexported totp key")
+			}
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to write export secret: %v", err), errorRequeueTime)
+		}
+		obj.Status.Exported = true
+	}
+
+	return r.updateStatus(ctx, obj, true,
+		"TOTP key created successfully", defaultRequeueTime)
+}
+
+// totpKeyRequest builds the create request of a generated or provider-mode key.
+func (r *TOTPKeyReconciler) totpKeyRequest(ctx context.Context, obj *v1alpha1.TOTPKey) (schema.TotpCreateKeyRequest, error) {
+	spec := obj.Spec
+	request := schema.TotpCreateKeyRequest{
+		Generate:    spec.Generate,
+		Issuer:      spec.Issuer,
+		AccountName: spec.AccountName,
+		Algorithm:   spec.Algorithm,
+		Digits:      spec.Digits,
+		Period:      spec.Period,
+	}
+
+	if spec.Generate {
+		if spec.Issuer == "" || spec.AccountName == "" {
+			return request, fmt.Errorf("issuer and accountName are required to generate a key")
+		}
+		request.KeySize = spec.KeySize
+		request.Exported = spec.ExportSecretName != ""
+		if request.Exported {
+			request.QrSize = spec.QRSize
+		}
+		return request, nil
+	}
+
+	if (spec.URLSecretRef == nil) == (spec.KeySecretRef == nil) {
+		return request, fmt.Errorf("exactly one of urlSecretRef or keySecretRef must be set for a provider key")
+	}
+	if spec.ExportSecretName != "" {
+		return request, fmt.Errorf("exportSecretName is only supported for generated keys")
+	}
+
+	var err error
+	if spec.URLSecretRef != nil {
+		request.Url, err = getSecretKeyValue(ctx, r.Client, obj.Namespace, spec.URLSecretRef)
+	} else {
+		request.Key, err = getSecretKeyValue(ctx, r.Client, obj.Namespace, spec.KeySecretRef)
+	}
+
+	return request, err
+}
+
+func (r *TOTPKeyReconciler) writeExportSecret(ctx context.Context, obj *v1alpha1.TOTPKey, export *cvault.TOTPExport) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      obj.Spec.ExportSecretName,
+			Namespace: obj.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.StringData = map[string]string{
+			"barcode": export.Barcode,
+			"url":     export.URL,
+		}
+
+		return controllerutil.SetControllerReference(obj, secret, r.Scheme)
+	})
+
+	return err
+}
+
+func (r *TOTPKeyReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.TOTPKey, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.TOTPKey{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.Exported = latest.Status.Exported || obj.Status.Exported
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *TOTPKeyReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.TOTPKey, totpOp *cvault.TOTPOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, totpKeyFinalizer) {
+		err := totpOp.DeleteKey(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *TOTPKeyReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.TOTPKey) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, totpKeyFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, totpKeyFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TOTPKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.TOTPKey{}, specChanged).
+		Named("totpkey").
+		Complete(r)
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	transitKeyFinalizer = "transitkey.finalizers.ops.community.dev"
+)
+
+// TransitKeyReconciler reconciles a TransitKey object
+type TransitKeyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=transitkeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=transitkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=transitkeys/finalizers,verbs=update
+
+// Reconcile creates the key in the transit secret engine referenced by the
+// TransitKey, keeps its configuration in sync and rotates it whenever the
+// rotate annotation changes. Keys hold data that cannot be decrypted without
+// them, so they are only deleted from Vault when deletionAllowed is set.
+func (r *TransitKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Transit Key Reconciliation")
+
+	obj := &v1alpha1.TransitKey{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, obj.Spec.SecretEngine, "transit")
+	if err != nil {
+		if !obj.GetDeletionTimestamp().IsZero() && errors.IsNotFound(err) {
+			// the secret engine is already gone and its keys with it
+			return r.removeFinalizer(ctx, obj)
+		}
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	transitOp := cvault.NewTransitOperator(vaultOpInstance.Client)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, obj, transitOp, secretEngine.Spec.Path, vaultOpInstance.Token)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, transitKeyFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.AddFinalizer(obj, transitKeyFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to add finalizer: %v", err), errorRequeueTime)
+		}
+
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	mountPath := secretEngine.Spec.Path
+	key, err := transitOp.ReadKey(ctx, mountPath, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read transit key: %v", err), errorRequeueTime)
+	}
+
+	if key == nil {
+		err = transitOp.CreateKey(ctx, mountPath, obj.Spec.Name, schema.TransitCreateKeyRequest{
+			Type:                 obj.Spec.Type,
+			KeySize:              obj.Spec.KeySize,
+			Derived:              obj.Spec.Derived,
+			ConvergentEncryption: obj.Spec.ConvergentEncryption,
+			Exportable:           obj.Spec.Exportable,
+			AllowPlaintextBackup: obj.Spec.AllowPlaintextBackup,
+			AutoRotatePeriod:     obj.Spec.AutoRotatePeriod,
+		}, vaultOpInstance.Token)
+		if err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to create transit key: %v", err), errorRequeueTime)
+		}
+	} else if key.Type != obj.Spec.Type {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Transit key %s has type %s, it cannot be changed to %s", obj.Spec.Name, key.Type, obj.Spec.Type), errorRequeueTime)
+	}
+
+	err = transitOp.ConfigureKey(ctx, mountPath, obj.Spec.Name, transitKeyConfigData(obj.Spec), vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to configure transit key: %v", err), errorRequeueTime)
+	}
+
+	if request := obj.GetAnnotations()[v1alpha1.TransitKeyRotateAnnotation]; request != "" && request != obj.Status.LastRotationRequest {
+		if err := transitOp.RotateKey(ctx, mountPath, obj.Spec.Name, vaultOpInstance.Token); err != nil {
+			return r.updateStatus(ctx, obj, false,
+				fmt.Sprintf("Failed to rotate transit key: %v", err), errorRequeueTime)
+		}
+		obj.Status.LastRotationRequest = request
+		obj.Status.LastRotationTime = &metav1.Time{Time: time.Now()}
+	}
+
+	key, err = transitOp.ReadKey(ctx, mountPath, obj.Spec.Name, vaultOpInstance.Token)
+	if err != nil || key == nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read back transit key: %v", err), errorRequeueTime)
+	}
+	obj.Status.LatestVersion = key.LatestVersion
+	obj.Status.MinAvailableVersion = key.MinAvailableVersion
+	obj.Status.MinDecryptionVersion = key.MinDecryptionVersion
+	obj.Status.MinEncryptionVersion = key.MinEncryptionVersion
+
+	return r.updateStatus(ctx, obj, true,
+		"Transit key synchronized successfully", defaultRequeueTime)
+}
+
+// transitKeyConfigData builds the raw config request. Exportable and
+// allow_plaintext_backup cannot be turned off in Vault, so they are only sent
+// when set.
+func transitKeyConfigData(spec v1alpha1.TransitKeySpec) map[string]interface{} {
+	data := map[string]interface{}{
+		"deletion_allowed":       spec.DeletionAllowed,
+		"min_decryption_version": spec.MinDecryptionVersion,
+		"min_encryption_version": spec.MinEncryptionVersion,
+		"auto_rotate_period":     "0",
+	}
+
+	setIfNotEmpty(data, "auto_rotate_period", spec.AutoRotatePeriod)
+	if spec.Exportable {
+		data["exportable"] = true
+	}
+	if spec.AllowPlaintextBackup {
+		data["allow_plaintext_backup"] = true
+	}
+
+	return data
+}
+
+func (r *TransitKeyReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.TransitKey, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.TransitKey{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.LatestVersion = obj.Status.LatestVersion
+		latest.Status.MinAvailableVersion = obj.Status.MinAvailableVersion
+		latest.Status.MinDecryptionVersion = obj.Status.MinDecryptionVersion
+		latest.Status.MinEncryptionVersion = obj.Status.MinEncryptionVersion
+		latest.Status.LastRotationRequest = obj.Status.LastRotationRequest
+		latest.Status.LastRotationTime = obj.Status.LastRotationTime
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *TransitKeyReconciler) handleDeletion(ctx context.Context, obj *v1alpha1.TransitKey, transitOp *cvault.TransitOperator, mountPath string, token string) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, transitKeyFinalizer) && obj.Spec.DeletionAllowed {
+		err := transitOp.DeleteKey(ctx, mountPath, obj.Spec.Name, token)
+		if err != nil {
+			// requeue on error for proper cleaning
+			return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+		}
+	}
+
+	return r.removeFinalizer(ctx, obj)
+}
+
+func (r *TransitKeyReconciler) removeFinalizer(ctx context.Context, obj *v1alpha1.TransitKey) (ctrl.Result, error) {
+	if controllerutil.ContainsFinalizer(obj, transitKeyFinalizer) {
+		patch := client.MergeFrom(obj.DeepCopy())
+		controllerutil.RemoveFinalizer(obj, transitKeyFinalizer)
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			// no requeue for a deletion patch error
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Stop reconciliation as the item is deleted
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager. Besides spec
+// changes, annotation changes trigger a reconcile so a rotation request is
+// acted on immediately.
+func (r *TransitKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.TransitKey{}, builder.WithPredicates(predicate.Or[client.Object](
+			predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Named("transitkey").
+		Complete(r)
+}
@@ -18,11 +18,16 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +53,7 @@ type UserPassReconciler struct {
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=userpasses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=userpasses/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vault.ops.community.dev,resources=userpasses/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -68,7 +74,7 @@ func (r *UserPassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, req.Namespace, r.Client)
+	vaultOpInstance, err := getVaultOpClient(ctx, obj.Spec.VaultServer.Name, obj.Spec.VaultServer.Namespace, obj, r.Client)
 	if err != nil {
 		return r.updateStatus(ctx, obj, false, fmt.Sprintf("Failed to get vault client: %v", err), errorRequeueTime)
 	}
@@ -90,21 +96,157 @@ func (r *UserPassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{RequeueAfter: 0}, nil
 	}
 
-	err = vaultUserOp.CreateUser(obj.Spec.MountPath, obj.Spec.Name, obj.Spec.Password, obj.Spec.Policies, vaultOpInstance.Token)
+	password, err := r.resolvePassword(ctx, obj, vaultUserOp, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve password: %v", err), errorRequeueTime)
+	}
+
+	passwordHash := userPassPasswordHash(obj, password)
+	err = vaultUserOp.EnsureUser(ctx, obj.Spec.MountPath, obj.Spec.Name, password,
+		passwordHash != obj.Status.PasswordHash, userPassSettings(obj.Spec), vaultOpInstance.Token)
 	if err != nil {
 		return r.updateStatus(ctx, obj, false,
 			fmt.Sprintf("Failed to create/update user: %v", err), errorRequeueTime)
 	}
 
+	obj.Status.PasswordHash = passwordHash
 	return r.updateStatus(ctx, obj, true,
-		"User synchronized successfully", defaultRequeueTime)
+		"User synchronized successfully", userPassRequeue(obj, time.Now()))
+
+}
+
+// resolvePassword returns the password from the referenced Secret or, for a
+// generated password, from the owned Secret, generating a new one when the
+// Secret is missing or the rotation period has passed. The deprecated
+// spec.password is only used when neither source is set.
+func (r *UserPassReconciler) resolvePassword(ctx context.Context, obj *v1alpha1.UserPass, vaultUserOp *cvault.UserPassOperator, token string) (string, error) {
+	if obj.Spec.PasswordSecretRef != nil && obj.Spec.GeneratePassword != nil {
+		return "", fmt.Errorf("only one of passwordSecretRef or generatePassword may be set")
+	}
+
+	if obj.Spec.PasswordSecretRef == nil && obj.Spec.GeneratePassword == nil {
+		if obj.Spec.Password == "" {
+			return "", fmt.Errorf("one of passwordSecretRef or generatePassword must be set")
+		}
+		logf.FromContext(ctx).Info("spec.password is deprecated, use passwordSecretRef or generatePassword")
+		return obj.Spec.Password, nil
+	}
+
+	if obj.Spec.PasswordSecretRef != nil {
+		return getSecretKeyValue(ctx, r.Client, obj.Namespace, obj.Spec.PasswordSecretRef)
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: userPassSecretName(obj), Namespace: obj.Namespace}, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil {
+		// never hand out or overwrite the password of a Secret we do not own
+		if err := checkControlled(secret, obj); err != nil {
+			return "", err
+		}
+	}
+
+	now := time.Now()
+	if err == nil && len(secret.Data["password"]) > 0 {
+		if obj.Status.LastPasswordRotation == nil {
+			// status lost or written by an older version, count from the Secret
+			obj.Status.LastPasswordRotation = &secret.CreationTimestamp
+		}
+		if !passwordRotationDue(obj, now) {
+			return string(secret.Data["password"]), nil
+		}
+	}
+
+	password, err := vaultUserOp.GeneratePassword(ctx, obj.Spec.GeneratePassword.Policy, obj.Spec.GeneratePassword.Length, token)
+	if err != nil {
+		return "", err
+	}
+
+	// the Secret is written first so a failed Vault update is retried with it
+	if err := r.writePasswordSecret(ctx, obj, password); err != nil {
+		return "", fmt.Errorf("failed to write password secret: %w", err)
+	}
+	obj.Status.LastPasswordRotation = &metav1.Time{Time: now}
+
+	return password, nil
+}
+
+func (r *UserPassReconciler) writePasswordSecret(ctx context.Context, obj *v1alpha1.UserPass, password string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      userPassSecretName(obj),
+			Namespace: obj.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := checkControlled(secret, obj); err != nil {
+			return err
+		}
+
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			"username": []byte(obj.Spec.Name),
+			"password": []byte(password),
+		}
+
+		return controllerutil.SetControllerReference(obj, secret, r.Scheme)
+	})
 
+	return err
+}
+
+func userPassSecretName(obj *v1alpha1.UserPass) string {
+	if obj.Spec.GeneratePassword.SecretName != "" {
+		return obj.Spec.GeneratePassword.SecretName
+	}
+	return obj.Name
+}
+
+func passwordRotationDue(obj *v1alpha1.UserPass, now time.Time) bool {
+	period := obj.Spec.GeneratePassword.RotationPeriod
+	if period == nil || period.Duration <= 0 || obj.Status.LastPasswordRotation == nil {
+		return false
+	}
+	return !now.Before(obj.Status.LastPasswordRotation.Add(period.Duration))
+}
+
+// userPassRequeue requeues at the next password rotation, but at least
+// every defaultRequeueTime so referenced Secrets are picked up.
+func userPassRequeue(obj *v1alpha1.UserPass, now time.Time) time.Duration {
+	if obj.Spec.GeneratePassword == nil || obj.Spec.GeneratePassword.RotationPeriod == nil || obj.Status.LastPasswordRotation == nil {
+		return defaultRequeueTime
+	}
+
+	next := obj.Status.LastPasswordRotation.Add(obj.Spec.GeneratePassword.RotationPeriod.Duration).Sub(now)
+	if next < time.Second {
+		next = time.Second
+	}
+	return min(next, defaultRequeueTime)
+}
+
+// userPassSettings holds everything but the password, rewritten on every
+// reconcile so changes to the spec reach Vault. Unset token settings are sent
+// as their zero values to reset anything removed from the spec.
+func userPassSettings(spec v1alpha1.UserPassSpec) map[string]interface{} {
+	return tokenSettingsData(spec.Policies, spec.TokenSettings)
+}
+
+// userPassPasswordHash salts the password with the object UID so the status
+// does not expose a plain hash of common passwords.
+func userPassPasswordHash(obj *v1alpha1.UserPass, password string) string {
+	sum := sha256.Sum256([]byte(string(obj.UID) + ":" + password))
+	return hex.EncodeToString(sum[:])
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *UserPassReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&vaultv1alpha1.UserPass{}).
+		Owns(&corev1.Secret{}).
 		Named("userpass").
 		Complete(r)
 }
@@ -119,6 +261,12 @@ func (r *UserPassReconciler) updateStatus(ctx context.Context, obj *v1alpha1.Use
 		latest.Status.Message = message
 		latest.Status.Synchronized = strconv.FormatBool(sync)
 		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if obj.Status.PasswordHash != "" {
+			latest.Status.PasswordHash = obj.Status.PasswordHash
+		}
+		if obj.Status.LastPasswordRotation != nil {
+			latest.Status.LastPasswordRotation = obj.Status.LastPasswordRotation
+		}
 		return r.Status().Update(ctx, latest)
 	})
 
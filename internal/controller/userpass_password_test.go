@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestUserPassResolveDeprecatedPassword(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-password", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("from-secret")},
+	}
+	r := &UserPassReconciler{Client: newFakeClient(t, secret)}
+
+	obj := &v1alpha1.UserPass{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default"},
+		Spec:       v1alpha1.UserPassSpec{Name: "user", Password: "change_me"},
+	}
+	password, err := r.resolvePassword(ctx, obj, nil, "token")
+	require.NoError(t, err)
+	assert.Equal(t, "change_me", password)
+
+	// a password source added next to the deprecated field takes precedence
+	obj.Spec.PasswordSecretRef = &v1alpha1.SecretKeyReference{Name: "user-password", Key: "password"}
+	password, err = r.resolvePassword(ctx, obj, nil, "token")
+	require.NoError(t, err)
+	assert.Equal(t, "from-secret", password)
+
+	obj.Spec.Password = ""
+	obj.Spec.PasswordSecretRef = nil
+	_, err = r.resolvePassword(ctx, obj, nil, "token")
+	assert.Error(t, err)
+}
+
+func TestUserPassGeneratedPasswordRefusesForeignSecret(t *testing.T) {
+	ctx := context.Background()
+	obj := &v1alpha1.UserPass{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: "default", UID: "uid-1"},
+		Spec: v1alpha1.UserPassSpec{
+			Name:             "user",
+			GeneratePassword: &v1alpha1.GeneratedPassword{SecretName: "app", Length: 24},
+		},
+	}
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("keep-me")},
+	}
+	c := newFakeClient(t, obj, foreign)
+	r := &UserPassReconciler{Client: c, Scheme: c.Scheme()}
+
+	_, err := r.resolvePassword(ctx, obj, nil, "token")
+	assert.Error(t, err)
+
+	assert.Error(t, r.writePasswordSecret(ctx, obj, "new"))
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "app", Namespace: "default"}, secret))
+	assert.Equal(t, "keep-me", string(secret.Data["password"]))
+}
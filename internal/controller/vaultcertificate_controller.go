@@ -0,0 +1,293 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	vaultv1alpha1 "github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultRenewAtPercent = 66
+)
+
+// VaultCertificateReconciler reconciles a VaultCertificate object
+type VaultCertificateReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=vaultcertificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=vaultcertificates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vault.ops.community.dev,resources=vaultcertificates/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile issues a certificate from the referenced PKIRole into a
+// kubernetes.io/tls Secret and issues a new one once the configured share of
+// its lifetime has passed. The Secret is owned by the VaultCertificate and
+// garbage collected with it, so no finalizer is needed here.
+func (r *VaultCertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+	logger.Info("Starting Vault Certificate Reconciliation")
+
+	obj := &v1alpha1.VaultCertificate{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	reason, err := r.issueReason(ctx, obj)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to read certificate secret: %v", err), errorRequeueTime)
+	}
+
+	if reason == "" {
+		return r.updateStatus(ctx, obj, true,
+			fmt.Sprintf("Certificate valid until %s", obj.Status.NotAfter.UTC().Format(time.RFC3339)),
+			min(time.Until(obj.Status.RenewalTime.Time), defaultRequeueTime))
+	}
+
+	pkiRole, err := getPKIRole(ctx, r.Client, req.Namespace, obj.Spec.PKIRole)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve pki role: %v", err), errorRequeueTime)
+	}
+
+	secretEngine, err := getSecretEngine(ctx, r.Client, req.Namespace, pkiRole.Spec.SecretEngine, "pki")
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to resolve secret engine: %v", err), errorRequeueTime)
+	}
+
+	vaultOpInstance, err := getVaultOpClient(ctx, secretEngine.Spec.VaultServer.Name, secretEngine.Spec.VaultServer.Namespace, obj, r.Client)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to get vault operator client: %v", err), errorRequeueTime)
+	}
+
+	logger.Info("Issuing certificate", "reason", reason)
+	pkiOp := cvault.NewPKIOperator(vaultOpInstance.Client)
+	issued, err := pkiOp.IssueCertificate(ctx, secretEngine.Spec.Path, pkiRole.Spec.Name, schema.PkiIssueWithRoleRequest{
+		CommonName: obj.Spec.CommonName,
+		AltNames:   strings.Join(obj.Spec.AltNames, ","),
+		IpSans:     obj.Spec.IPSANs,
+		UriSans:    obj.Spec.URISANs,
+		Ttl:        obj.Spec.TTL,
+	}, vaultOpInstance.Token)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to issue certificate: %v", err), errorRequeueTime)
+	}
+
+	notBefore, notAfter, err := certificateValidity(issued.Certificate)
+	if err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to parse issued certificate: %v", err), errorRequeueTime)
+	}
+
+	if err := r.writeSecret(ctx, obj, issued); err != nil {
+		return r.updateStatus(ctx, obj, false,
+			fmt.Sprintf("Failed to write certificate secret: %v", err), errorRequeueTime)
+	}
+
+	renewalTime := renewalTime(notBefore, notAfter, obj.Spec.RenewAtPercent)
+	obj.Status.SerialNumber = issued.SerialNumber
+	obj.Status.NotBefore = &metav1.Time{Time: notBefore}
+	obj.Status.NotAfter = &metav1.Time{Time: notAfter}
+	obj.Status.RenewalTime = &metav1.Time{Time: renewalTime}
+	obj.Status.IssuedGeneration = obj.Generation
+	obj.Status.IssuedSpecHash = certificateSpecHash(obj.Spec)
+
+	return r.updateStatus(ctx, obj, true,
+		fmt.Sprintf("Certificate issued, valid until %s", notAfter.UTC().Format(time.RFC3339)),
+		min(time.Until(renewalTime), defaultRequeueTime))
+}
+
+// issueReason tells why a new certificate is needed, or returns an empty
+// string when the current one is still good.
+func (r *VaultCertificateReconciler) issueReason(ctx context.Context, obj *v1alpha1.VaultCertificate) (string, error) {
+	if obj.Status.NotAfter == nil || obj.Status.RenewalTime == nil {
+		return "not issued yet", nil
+	}
+	if obj.Status.IssuedSpecHash != certificateSpecHash(obj.Spec) {
+		return "spec changed", nil
+	}
+	if obj.Status.NotBefore != nil {
+		// follow a changed renewAtPercent without issuing a new certificate
+		renewal := renewalTime(obj.Status.NotBefore.Time, obj.Status.NotAfter.Time, obj.Spec.RenewAtPercent)
+		obj.Status.RenewalTime = &metav1.Time{Time: renewal}
+	}
+	if !time.Now().Before(obj.Status.RenewalTime.Time) {
+		return "renewal time reached", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: certificateSecretName(obj), Namespace: obj.Namespace}, secret)
+	if errors.IsNotFound(err) {
+		return "secret missing", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return "secret incomplete", nil
+	}
+
+	return "", nil
+}
+
+// certificateSpecHash hashes the spec fields that change the issued
+// certificate.
+func certificateSpecHash(spec v1alpha1.VaultCertificateSpec) string {
+	data, _ := json.Marshal(struct {
+		PKIRole    v1alpha1.PKIRoleReference
+		CommonName string
+		AltNames   []string
+		IPSANs     []string
+		URISANs    []string
+		TTL        string
+	}{spec.PKIRole, spec.CommonName, spec.AltNames, spec.IPSANs, spec.URISANs, spec.TTL})
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *VaultCertificateReconciler) writeSecret(ctx context.Context, obj *v1alpha1.VaultCertificate, issued *schema.PkiIssueWithRoleResponse) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      certificateSecretName(obj),
+			Namespace: obj.Namespace,
+		},
+	}
+
+	chain := issued.CaChain
+	if len(chain) == 0 && issued.IssuingCa != "" {
+		chain = []string{issued.IssuingCa}
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := checkControlled(secret, obj); err != nil {
+			return err
+		}
+
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			// leaf first, followed by the chain up to the root
+			corev1.TLSCertKey:       []byte(strings.Join(append([]string{issued.Certificate}, chain...), "\n")),
+			corev1.TLSPrivateKeyKey: []byte(issued.PrivateKey),
+			"ca.crt":                []byte(strings.Join(chain, "\n")),
+		}
+
+		return controllerutil.SetControllerReference(obj, secret, r.Scheme)
+	})
+
+	return err
+}
+
+func certificateSecretName(obj *v1alpha1.VaultCertificate) string {
+	if obj.Spec.SecretName != "" {
+		return obj.Spec.SecretName
+	}
+	return obj.Name
+}
+
+func certificateValidity(certificate string) (time.Time, time.Time, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("no PEM block found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return cert.NotBefore, cert.NotAfter, nil
+}
+
+// renewalTime returns the point at which percent of the lifetime between
+// notBefore and notAfter has elapsed.
+func renewalTime(notBefore, notAfter time.Time, percent int32) time.Time {
+	if percent <= 0 || percent >= 100 {
+		percent = defaultRenewAtPercent
+	}
+
+	lifetime := notAfter.Sub(notBefore)
+	return notBefore.Add(lifetime / 100 * time.Duration(percent))
+}
+
+func (r *VaultCertificateReconciler) updateStatus(ctx context.Context, obj *vaultv1alpha1.VaultCertificate, synchronized bool, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &v1alpha1.VaultCertificate{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+
+		latest.Status.Message = message
+		latest.Status.Synchronized = strconv.FormatBool(synchronized)
+		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		latest.Status.SerialNumber = obj.Status.SerialNumber
+		latest.Status.NotBefore = obj.Status.NotBefore
+		latest.Status.NotAfter = obj.Status.NotAfter
+		latest.Status.RenewalTime = obj.Status.RenewalTime
+		latest.Status.IssuedGeneration = obj.Status.IssuedGeneration
+		latest.Status.IssuedSpecHash = obj.Status.IssuedSpecHash
+
+		return r.Status().Update(ctx, latest)
+	})
+
+	logger := logf.FromContext(ctx)
+
+	if err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{RequeueAfter: errorRequeueTime}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VaultCertificateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&vaultv1alpha1.VaultCertificate{}, specChanged).
+		Owns(&corev1.Secret{}).
+		Named("vaultcertificate").
+		Complete(r)
+}
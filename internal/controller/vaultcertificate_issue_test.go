@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+)
+
+func TestVaultCertificateIssueReason(t *testing.T) {
+	issued := func() *v1alpha1.VaultCertificate {
+		now := time.Now()
+		obj := &v1alpha1.VaultCertificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 1},
+			Spec: v1alpha1.VaultCertificateSpec{
+				PKIRole:        v1alpha1.PKIRoleReference{Name: "web"},
+				CommonName:     "www.example.com",
+				RenewAtPercent: 66,
+			},
+		}
+		obj.Status.NotBefore = &metav1.Time{Time: now.Add(-time.Hour)}
+		obj.Status.NotAfter = &metav1.Time{Time: now.Add(9 * time.Hour)}
+		obj.Status.RenewalTime = &metav1.Time{Time: renewalTime(obj.Status.NotBefore.Time, obj.Status.NotAfter.Time, 66)}
+		obj.Status.IssuedGeneration = 1
+		obj.Status.IssuedSpecHash = certificateSpecHash(obj.Spec)
+		return obj
+	}
+
+	testCases := []struct {
+		name   string
+		mutate func(obj *v1alpha1.VaultCertificate)
+		reason string
+	}{
+		{
+			name:   "unchanged",
+			mutate: func(obj *v1alpha1.VaultCertificate) {},
+		},
+		{
+			name: "renewal setting changed",
+			mutate: func(obj *v1alpha1.VaultCertificate) {
+				obj.Generation = 2
+				obj.Spec.RenewAtPercent = 50
+			},
+		},
+		{
+			name: "renewal setting moved before now",
+			mutate: func(obj *v1alpha1.VaultCertificate) {
+				obj.Generation = 2
+				obj.Spec.RenewAtPercent = 5
+			},
+			reason: "renewal time reached",
+		},
+		{
+			name: "names changed",
+			mutate: func(obj *v1alpha1.VaultCertificate) {
+				obj.Generation = 2
+				obj.Spec.AltNames = []string{"example.com"}
+			},
+			reason: "spec changed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := issued()
+			tc.mutate(obj)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("crt"), corev1.TLSPrivateKeyKey: []byte("key")},
+			}
+			r := &VaultCertificateReconciler{Client: newFakeClient(t, secret)}
+
+			reason, err := r.issueReason(context.Background(), obj)
+			require.NoError(t, err)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestVaultCertificateWriteSecretRefusesForeignSecret(t *testing.T) {
+	ctx := context.Background()
+	obj := &v1alpha1.VaultCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "uid-1"},
+	}
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("keep-me")},
+	}
+	c := newFakeClient(t, obj, foreign)
+	r := &VaultCertificateReconciler{Client: c, Scheme: c.Scheme()}
+
+	err := r.writeSecret(ctx, obj, &schema.PkiIssueWithRoleResponse{Certificate: "leaf", PrivateKey: "key"})
+	assert.Error(t, err)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "web", Namespace: "default"}, secret))
+	assert.Equal(t, "keep-me", string(secret.Data[corev1.TLSCertKey]))
+}
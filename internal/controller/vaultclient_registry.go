@@ -0,0 +1,459 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/vault-client-go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+// vaultTokenSecretSuffix names the Secret holding the init data of a
+// VaultServer, <vaultserver>-secret.
+const vaultTokenSecretSuffix = "-secret"
+
+// vaultClients is shared by every reconciler, so each VaultServer gets a
+// single client and connection pool.
+var vaultClients = newVaultClientRegistry()
+
+// vaultClientKey identifies the credentials a cached client was built with.
+type vaultClientKey struct {
+	UID          types.UID
+	TokenVersion string
+}
+
+// vaultClientConfig is what a client is built from. A change rebuilds the
+// client.
+type vaultClientConfig struct {
+	url                string
+	caSecret           types.NamespacedName
+	caCert             string
+	serverName         string
+	insecureSkipVerify bool
+	namespace          string
+}
+
+type vaultClientEntry struct {
+	key    vaultClientKey
+	config vaultClientConfig
+	client cvault.VaultClientI
+	token  string
+}
+
+// vaultClientRegistry caches one client per VaultServer. Entries are keyed
+// by the VaultServer UID and the resourceVersion of its token Secret. They
+// are invalidated by the watches set up in SetupVaultClientRegistry: a change
+// of the VaultServer spec or of its CA bundle Secret drops the client, a
+// change of its token Secret drops the cached token. The VaultServer
+// reconciler also syncs the token on every pass. The registry records the
+// last health check of each server as well.
+type vaultClientRegistry struct {
+	mu      sync.RWMutex
+	watched bool
+	entries map[types.NamespacedName]*vaultClientEntry
+	// epoch is bumped on every invalidation so a lookup racing with it does
+	// not cache credentials read before. A single counter keeps the registry
+	// from growing with every server ever seen.
+	epoch  uint64
+	health map[types.NamespacedName]error
+}
+
+func newVaultClientRegistry() *vaultClientRegistry {
+	return &vaultClientRegistry{
+		entries: map[types.NamespacedName]*vaultClientEntry{},
+		health:  map[types.NamespacedName]error{},
+	}
+}
+
+// cached returns the operator client of a server without reading the
+// VaultServer or its token Secret. It only answers once the watches are in
+// place, since nothing else would invalidate the entry.
+func (r *vaultClientRegistry) cached(name types.NamespacedName) (*VaultOperatorClient, uint64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	epoch := r.epoch
+	entry, ok := r.entries[name]
+	if !r.watched || !ok || entry.key.TokenVersion == "" {
+		return nil, epoch, false
+	}
+
+	return entry.operatorClient(name), epoch, true
+}
+
+// store returns the operator client for key, reusing the pooled client when
+// the server and its connection settings did not change. The token is cached only when no
+// invalidation happened since epoch was read.
+func (r *vaultClientRegistry) store(name types.NamespacedName, key vaultClientKey, config vaultClientConfig, token string, epoch uint64) (*VaultOperatorClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, err := r.serverEntry(name, key.UID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.epoch == epoch {
+		entry.key = key
+		entry.token = token
+	}
+
+	opClient := entry.operatorClient(name)
+	opClient.Token = token
+	return opClient, nil
+}
+
+// client returns the pooled client of a server, for callers that do not
+// need its token.
+func (r *vaultClientRegistry) client(name types.NamespacedName, uid types.UID, config vaultClientConfig) (cvault.VaultClientI, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, err := r.serverEntry(name, uid, config)
+	if err != nil {
+		return nil, err
+	}
+	return entry.client, nil
+}
+
+// serverEntry must be called with the lock held.
+func (r *vaultClientRegistry) serverEntry(name types.NamespacedName, uid types.UID, config vaultClientConfig) (*vaultClientEntry, error) {
+	if entry, ok := r.entries[name]; ok && entry.key.UID == uid && entry.config == config {
+		return entry, nil
+	}
+
+	vaultClient, err := newVaultClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	r.dropLocked(name)
+	entry := &vaultClientEntry{key: vaultClientKey{UID: uid}, config: config, client: vaultClient}
+	r.entries[name] = entry
+	return entry, nil
+}
+
+// syncToken drops the cached token of a server when its token Secret moved
+// past the cached version, keeping the client. An empty version means the
+// Secret is gone.
+func (r *vaultClientRegistry) syncToken(name types.NamespacedName, uid types.UID, version string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[name]
+	if !ok || entry.key.UID != uid {
+		return
+	}
+	r.syncTokenLocked(entry, version)
+}
+
+// secretChanged drops what the cached clients derived from a Secret: the
+// client of a server using it as CA bundle, along with the last health check
+// made with the previous bundle, and the cached token of a server using it as
+// token Secret. An empty version means the Secret is gone.
+func (r *vaultClientRegistry) secretChanged(secret types.NamespacedName, version string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, entry := range r.entries {
+		switch {
+		case entry.config.caSecret == secret:
+			r.epoch++
+			r.dropLocked(name)
+			delete(r.health, name)
+		case secret == vaultTokenSecret(name):
+			r.syncTokenLocked(entry, version)
+		}
+	}
+}
+
+// syncTokenLocked must be called with the lock held.
+func (r *vaultClientRegistry) syncTokenLocked(entry *vaultClientEntry, version string) {
+	if entry.key.TokenVersion == "" || entry.key.TokenVersion == version {
+		return
+	}
+
+	r.epoch++
+	entry.key.TokenVersion = ""
+	entry.token = ""
+}
+
+// invalidate drops the client of a server, closing its idle connections.
+func (r *vaultClientRegistry) invalidate(name types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.epoch++
+	r.dropLocked(name)
+}
+
+// remove forgets everything about a deleted server.
+func (r *vaultClientRegistry) remove(name types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.epoch++
+	r.dropLocked(name)
+	delete(r.health, name)
+}
+
+func (r *vaultClientRegistry) dropLocked(name types.NamespacedName) {
+	entry, ok := r.entries[name]
+	if !ok {
+		return
+	}
+	delete(r.entries, name)
+
+	if closer, ok := entry.client.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// setHealth records the outcome of the last health check of a server; a nil
+// err marks it healthy.
+func (r *vaultClientRegistry) setHealth(name types.NamespacedName, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err == nil {
+		delete(r.health, name)
+		return
+	}
+	r.health[name] = err
+}
+
+// checkHealth returns the error of the last failed health check of a server.
+// Servers never checked are assumed healthy.
+func (r *vaultClientRegistry) checkHealth(name types.NamespacedName) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if err, ok := r.health[name]; ok {
+		return fmt.Errorf("vault server %s is not healthy: %w", name, err)
+	}
+	return nil
+}
+
+func (r *vaultClientRegistry) setWatched() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.watched = true
+}
+
+func (e *vaultClientEntry) operatorClient(name types.NamespacedName) *VaultOperatorClient {
+	return &VaultOperatorClient{
+		Name:      name.Name,
+		Namespace: name.Namespace,
+		Client:    e.client,
+		Token:     e.token,
+	}
+}
+
+// SetupVaultClientRegistry watches VaultServers and Secrets to invalidate the
+// shared client registry. Until it is called every lookup reads the
+// VaultServer, its CA bundle and its token Secret to validate the cached
+// client.
+func SetupVaultClientRegistry(ctx context.Context, mgr ctrl.Manager) error {
+	serverInformer, err := mgr.GetCache().GetInformer(ctx, &v1alpha1.VaultServer{})
+	if err != nil {
+		return err
+	}
+
+	_, err = serverInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldServer, ok := oldObj.(*v1alpha1.VaultServer)
+			if !ok {
+				return
+			}
+			newServer, ok := newObj.(*v1alpha1.VaultServer)
+			if !ok {
+				return
+			}
+			if oldServer.UID != newServer.UID || !equality.Semantic.DeepEqual(oldServer.Spec.Server, newServer.Spec.Server) {
+				vaultClients.invalidate(client.ObjectKeyFromObject(newServer))
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if server, ok := deletedObject(obj); ok {
+				vaultClients.remove(client.ObjectKeyFromObject(server))
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	secretInformer, err := mgr.GetCache().GetInformer(ctx, &corev1.Secret{})
+	if err != nil {
+		return err
+	}
+
+	_, err = secretInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldSecret, ok := oldObj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			newSecret, ok := newObj.(*corev1.Secret)
+			if !ok || oldSecret.ResourceVersion == newSecret.ResourceVersion {
+				return
+			}
+			vaultClients.secretChanged(client.ObjectKeyFromObject(newSecret), newSecret.ResourceVersion)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if secret, ok := deletedObject(obj); ok {
+				vaultClients.secretChanged(client.ObjectKeyFromObject(secret), "")
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	vaultClients.setWatched()
+	return nil
+}
+
+// deletedObject unwraps the tombstones informers hand out for deletions
+// missed while disconnected.
+func deletedObject(obj interface{}) (client.Object, bool) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(client.Object)
+	return o, ok
+}
+
+// getVaultOpClient returns the shared client and root token of the
+// VaultServer referenced by obj, defaulting to the namespace of obj. The
+// VaultServer and its token Secret are only read when the registry has no
+// valid entry for them.
+//
+// A server whose last health check failed is refused, so controllers do not
+// pile up errors against a sealed or unreachable Vault. Objects being deleted
+// skip that check: their cleanup is attempted anyway, and a stale health
+// record must not keep their finalizers in place.
+func getVaultOpClient(ctx context.Context, name string, namespace string, obj client.Object, client client.Client) (*VaultOperatorClient, error) {
+
+	vaultOpSearch := types.NamespacedName{
+		Name:      name,
+		Namespace: namespace,
+	}
+
+	if vaultOpSearch.Namespace == "" {
+		vaultOpSearch.Namespace = obj.GetNamespace()
+	}
+
+	if obj.GetDeletionTimestamp().IsZero() {
+		if err := vaultClients.checkHealth(vaultOpSearch); err != nil {
+			return nil, err
+		}
+	}
+
+	opClient, epoch, ok := vaultClients.cached(vaultOpSearch)
+	if ok {
+		return opClient, nil
+	}
+
+	vaultOpInstance := &v1alpha1.VaultServer{}
+	if err := client.Get(ctx, vaultOpSearch, vaultOpInstance); err != nil {
+		return nil, fmt.Errorf("failed to get vault operator instance: %v", err)
+	}
+
+	vaultToken := &corev1.Secret{}
+	if err := client.Get(ctx, vaultTokenSecret(vaultOpSearch), vaultToken); err != nil {
+		return nil, err
+	}
+
+	config, err := vaultServerClientConfig(ctx, client, vaultOpInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	key := vaultClientKey{UID: vaultOpInstance.UID, TokenVersion: vaultToken.ResourceVersion}
+	opClient, err = vaultClients.store(vaultOpSearch, key, config, string(vaultToken.Data["root_token"]), epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect with vault: %v", err)
+	}
+
+	return opClient, nil
+}
+
+// vaultTokenSecret returns the name of the token Secret of a VaultServer.
+func vaultTokenSecret(server types.NamespacedName) types.NamespacedName {
+	return types.NamespacedName{Name: server.Name + vaultTokenSecretSuffix, Namespace: server.Namespace}
+}
+
+// vaultServerClientConfig resolves the connection settings of a VaultServer,
+// reading its CA bundle.
+func vaultServerClientConfig(ctx context.Context, c client.Client, server *v1alpha1.VaultServer) (vaultClientConfig, error) {
+	config := vaultClientConfig{
+		url:       buildURL(server.Spec.Server),
+		namespace: server.Spec.Server.VaultNamespace,
+	}
+
+	tls := server.Spec.Server.TLS
+	if tls == nil {
+		return config, nil
+	}
+
+	config.serverName = tls.ServerName
+	config.insecureSkipVerify = tls.InsecureSkipVerify
+	if tls.CASecretRef != nil {
+		caCert, err := getSecretKeyValue(ctx, c, server.Namespace, tls.CASecretRef)
+		if err != nil {
+			return config, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		config.caSecret = types.NamespacedName{Name: tls.CASecretRef.Name, Namespace: server.Namespace}
+		config.caCert = caCert
+	}
+
+	return config, nil
+}
+
+// newVaultClient builds the client used for every call to a Vault server, so
+// TLS, timeouts, retries and the namespace are configured in a single place.
+func newVaultClient(config vaultClientConfig) (cvault.VaultClientI, error) {
+	options := []cvault.VaultOption{cvault.WithTimeout(5), cvault.WithRetries(2)}
+
+	if config.caCert != "" || config.serverName != "" || config.insecureSkipVerify {
+		tls := vault.TLSConfiguration{
+			ServerName:         config.serverName,
+			InsecureSkipVerify: config.insecureSkipVerify,
+		}
+		tls.ServerCertificate.FromBytes = []byte(config.caCert)
+		options = append(options, cvault.WithTLS(tls))
+	}
+
+	if config.namespace != "" {
+		options = append(options, cvault.WithNamespace(config.namespace))
+	}
+
+	return cvault.GetClient(config.url, options...)
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/danielnegreiros/vault-operator/api/v1alpha1"
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+)
+
+var (
+	testServer = types.NamespacedName{Name: "vault", Namespace: "vault"}
+	testConfig = vaultClientConfig{url: "http://vault:8200"}
+)
+
+func TestVaultClientRegistryCachesOnlyWhenWatched(t *testing.T) {
+	r := newVaultClientRegistry()
+
+	_, epoch, ok := r.cached(testServer)
+	assert.False(t, ok)
+	_, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "token", epoch)
+	require.NoError(t, err)
+
+	_, _, ok = r.cached(testServer)
+	assert.False(t, ok, "without watches nothing would invalidate the entry")
+
+	r.setWatched()
+	opClient, _, ok := r.cached(testServer)
+	require.True(t, ok)
+	assert.Equal(t, "token", opClient.Token)
+	assert.Equal(t, testServer.Name, opClient.Name)
+}
+
+func TestVaultClientRegistrySharesClient(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	serverClient, err := r.client(testServer, "uid", testConfig)
+	require.NoError(t, err)
+
+	_, epoch, _ := r.cached(testServer)
+	opClient, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "token", epoch)
+	require.NoError(t, err)
+	assert.Same(t, serverClient, opClient.Client)
+
+	moved, err := r.client(testServer, "uid", vaultClientConfig{url: "http://vault-new:8200"})
+	require.NoError(t, err)
+	assert.NotSame(t, serverClient, moved, "a new URL gets a new client")
+
+	namespaced, err := r.client(testServer, "uid", vaultClientConfig{url: "http://vault-new:8200", namespace: "team-a"})
+	require.NoError(t, err)
+	assert.NotSame(t, moved, namespaced, "new connection settings get a new client")
+	moved = namespaced
+
+	recreated, err := r.client(testServer, "other-uid", vaultClientConfig{url: "http://vault-new:8200"})
+	require.NoError(t, err)
+	assert.NotSame(t, moved, recreated, "a recreated VaultServer gets a new client")
+	_, _, ok := r.cached(testServer)
+	assert.False(t, ok, "the token of the previous VaultServer is dropped")
+}
+
+func TestVaultClientRegistryStoreRace(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	_, epoch, _ := r.cached(testServer)
+	_, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "token", epoch)
+	require.NoError(t, err)
+
+	// a lookup reads the old token while the token Secret changes
+	_, epoch, _ = r.cached(testServer)
+	r.syncToken(testServer, "uid", "2")
+	opClient, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "stale", epoch)
+	require.NoError(t, err)
+
+	assert.Equal(t, "stale", opClient.Token, "the caller still gets the token it read")
+	_, _, ok := r.cached(testServer)
+	assert.False(t, ok, "but it is not cached")
+
+	_, epoch, _ = r.cached(testServer)
+	_, err = r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "2"}, testConfig, "fresh", epoch)
+	require.NoError(t, err)
+	opClient, _, ok = r.cached(testServer)
+	require.True(t, ok)
+	assert.Equal(t, "fresh", opClient.Token)
+}
+
+func TestVaultClientRegistrySyncToken(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	_, epoch, _ := r.cached(testServer)
+	_, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "token", epoch)
+	require.NoError(t, err)
+
+	r.syncToken(testServer, "uid", "1")
+	_, _, ok := r.cached(testServer)
+	assert.True(t, ok, "an unchanged token Secret keeps the entry")
+
+	r.syncToken(testServer, "other-uid", "2")
+	_, _, ok = r.cached(testServer)
+	assert.True(t, ok, "Secrets of another VaultServer are ignored")
+
+	r.syncToken(testServer, "uid", "")
+	_, _, ok = r.cached(testServer)
+	assert.False(t, ok, "a deleted token Secret drops the token")
+}
+
+func TestVaultClientRegistryInvalidate(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	first, err := r.client(testServer, "uid", testConfig)
+	require.NoError(t, err)
+	r.setHealth(testServer, errors.New("sealed"))
+
+	r.invalidate(testServer)
+	second, err := r.client(testServer, "uid", testConfig)
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Error(t, r.checkHealth(testServer), "health survives a client rebuild")
+
+	r.remove(testServer)
+	assert.NoError(t, r.checkHealth(testServer), "a removed server is forgotten")
+	assert.Empty(t, r.entries)
+}
+
+func TestVaultClientRegistryHealth(t *testing.T) {
+	r := newVaultClientRegistry()
+	assert.NoError(t, r.checkHealth(testServer), "unchecked servers are assumed healthy")
+
+	r.setHealth(testServer, errors.New("sealed"))
+	err := r.checkHealth(testServer)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sealed")
+
+	r.setHealth(testServer, nil)
+	assert.NoError(t, r.checkHealth(testServer))
+}
+
+func TestVaultClientRegistryConcurrentAccess(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 50 {
+				_, epoch, ok := r.cached(testServer)
+				if !ok {
+					_, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, testConfig, "token", epoch)
+					assert.NoError(t, err)
+				}
+				switch (i + j) % 3 {
+				case 0:
+					r.syncToken(testServer, "uid", "2")
+				case 1:
+					r.invalidate(testServer)
+				default:
+					assert.NoError(t, r.checkHealth(testServer))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGetVaultOpClient(t *testing.T) {
+	previous := vaultClients
+	vaultClients = newVaultClientRegistry()
+	vaultClients.setWatched()
+	t.Cleanup(func() { vaultClients = previous })
+
+	server := &v1alpha1.VaultServer{
+		ObjectMeta: metav1.ObjectMeta{Name: testServer.Name, Namespace: testServer.Namespace, UID: "uid"},
+		Spec:       v1alpha1.VaultServerSpec{Server: v1alpha1.VaultServerConfig{ServiceName: "vault", Port: 8200}},
+	}
+	token := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testServer.Name + vaultTokenSecretSuffix, Namespace: testServer.Namespace},
+		Data:       map[string][]byte{"root_token": []byte("root")},
+	}
+	c := newFakeClient(t, server, token)
+	ctx := context.Background()
+	obj := &v1alpha1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: testServer.Namespace}}
+
+	opClient, err := getVaultOpClient(ctx, testServer.Name, "", obj, c)
+	require.NoError(t, err)
+	assert.Equal(t, "root", opClient.Token)
+
+	// cached entries are served without reading the objects again
+	require.NoError(t, c.Delete(ctx, token))
+	cached, err := getVaultOpClient(ctx, testServer.Name, "", obj, c)
+	require.NoError(t, err)
+	assert.Same(t, opClient.Client, cached.Client)
+	assert.Equal(t, "root", cached.Token)
+
+	vaultClients.setHealth(testServer, errors.New("sealed"))
+	_, err = getVaultOpClient(ctx, testServer.Name, "", obj, c)
+	assert.ErrorContains(t, err, "not healthy")
+
+	// cleanup of a deleted object is attempted whatever the last health check
+	obj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deleting, err := getVaultOpClient(ctx, testServer.Name, "", obj, c)
+	require.NoError(t, err)
+	assert.Same(t, opClient.Client, deleting.Client)
+}
+
+func TestVaultClientRegistrySecretChanged(t *testing.T) {
+	r := newVaultClientRegistry()
+	r.setWatched()
+
+	caSecret := types.NamespacedName{Name: "vault-ca", Namespace: testServer.Namespace}
+	config := vaultClientConfig{url: "https://vault:8200", caSecret: caSecret}
+	_, epoch, _ := r.cached(testServer)
+	first, err := r.store(testServer, vaultClientKey{UID: "uid", TokenVersion: "1"}, config, "token", epoch)
+	require.NoError(t, err)
+
+	r.secretChanged(types.NamespacedName{Name: "other", Namespace: testServer.Namespace}, "5")
+	_, _, ok := r.cached(testServer)
+	assert.True(t, ok, "unrelated Secrets are ignored")
+
+	r.secretChanged(vaultTokenSecret(testServer), "1")
+	_, _, ok = r.cached(testServer)
+	assert.True(t, ok, "an unchanged token Secret keeps the entry")
+
+	r.secretChanged(vaultTokenSecret(testServer), "2")
+	_, _, ok = r.cached(testServer)
+	assert.False(t, ok, "a changed token Secret drops the token")
+	second, err := r.client(testServer, "uid", config)
+	require.NoError(t, err)
+	assert.Same(t, first.Client, second, "but keeps the client")
+
+	r.setHealth(testServer, errors.New("x509: certificate signed by unknown authority"))
+	r.secretChanged(caSecret, "7")
+	assert.Empty(t, r.entries, "a changed CA bundle drops the client")
+	assert.NoError(t, r.checkHealth(testServer), "and the health check made with the old bundle")
+}
+
+func TestVaultServerClientConfig(t *testing.T) {
+	server := &v1alpha1.VaultServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "vault"},
+		Spec: v1alpha1.VaultServerSpec{Server: v1alpha1.VaultServerConfig{
+			ServiceName:    "vault",
+			Port:           8200,
+			VaultNamespace: "team-a",
+			TLS: &v1alpha1.VaultServerTLS{
+				CASecretRef: &v1alpha1.SecretKeyReference{Name: "vault-ca", Key: "ca.crt"},
+				ServerName:  "vault.internal",
+			},
+		}},
+	}
+	ca := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-ca", Namespace: "vault"},
+		Data:       map[string][]byte{"ca.crt": []byte("pem")},
+	}
+
+	config, err := vaultServerClientConfig(context.Background(), newFakeClient(t, ca), server)
+	require.NoError(t, err)
+	assert.Equal(t, vaultClientConfig{
+		url:        "https://vault:8200",
+		caSecret:   types.NamespacedName{Name: "vault-ca", Namespace: "vault"},
+		caCert:     "pem",
+		serverName: "vault.internal",
+		namespace:  "team-a",
+	}, config)
+
+	_, err = vaultServerClientConfig(context.Background(), newFakeClient(t), server)
+	assert.Error(t, err, "a missing CA bundle is reported")
+}
+
+func TestNewVaultClientSettings(t *testing.T) {
+	var namespace string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace = r.Header.Get("X-Vault-Namespace")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	vaultClient, err := newVaultClient(vaultClientConfig{url: server.URL, serverName: "vault.internal", namespace: "team-a"})
+	require.NoError(t, err)
+
+	configured, ok := vaultClient.(*cvault.VaultClient)
+	require.True(t, ok)
+	assert.Equal(t, "vault.internal", configured.Configuration().TLS.ServerName)
+	assert.Equal(t, 2, configured.Configuration().RetryConfiguration.RetryMax)
+
+	_, err = vaultClient.Read(context.Background(), "sys/mounts")
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", namespace)
+}
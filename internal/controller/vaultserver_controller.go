@@ -26,9 +26,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -74,7 +74,6 @@ type VaultOperatorClient struct {
 	Token     string
 	Name      string
 	Namespace string
-	Endpoint  string
 }
 
 // VaultServerReconciler reconciles a VaultServer object
@@ -122,17 +121,26 @@ func (r *VaultServerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Build vault client
-	endpoint := buildURL(obj.Spec.Server)
-	vaultClient, err := cvault.GetClient(endpoint, cvault.WithTimeout(5))
+	config, err := vaultServerClientConfig(ctx, r.Client, obj)
+	if err != nil {
+		return r.updateStatus(ctx, obj, PhaseDataNotValidated, err.Error(), errorRequeueTime)
+	}
+
+	vaultClient, err := vaultClients.client(req.NamespacedName, obj.UID, config)
 	if err != nil {
 		return r.updateStatus(ctx, obj, PhaseDataNotValidated,
 			fmt.Sprintf("failed to build vault client: %v", err), errorRequeueTime)
 	}
 
+	if err := r.syncVaultToken(ctx, obj); err != nil {
+		logger.Error(err, "Failed to check the token secret")
+	}
+
 	vo := cvault.GetVaultOperator(vaultClient, nil)
 
 	// Check connectivity
 	if err := vo.Ping(ctx); err != nil {
+		vaultClients.setHealth(req.NamespacedName, err)
 		return r.updateStatus(ctx, obj, PhaseNotReachable,
 			fmt.Sprintf("vault not reachable: %v", err), errorRequeueTime)
 	}
@@ -140,6 +148,7 @@ func (r *VaultServerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Handle initialization
 	if obj.Spec.Init != nil && *obj.Spec.Init {
 		if err := r.handleInitialization(ctx, obj, vo, req); err != nil {
+			vaultClients.setHealth(req.NamespacedName, err)
 			return r.handleError(ctx, obj, err)
 		}
 	}
@@ -147,10 +156,14 @@ func (r *VaultServerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Handle auto-unlock
 	if obj.Spec.AutoUnlock != nil && *obj.Spec.AutoUnlock {
 		if err := r.handleAutoUnlock(ctx, obj, vo); err != nil {
+			vaultClients.setHealth(req.NamespacedName, err)
 			return r.handleError(ctx, obj, err)
 		}
 	}
 
+	vaultClients.setHealth(req.NamespacedName, nil)
+	r.checkAuditDevices(ctx, obj, vaultClient)
+
 	return r.updateStatus(ctx, obj, PhaseUnsealed, "Vault is operational", defaultRequeueTime)
 }
 
@@ -181,9 +194,24 @@ func (r *VaultServerReconciler) handleDeletion(ctx context.Context, obj *v1alpha
 			return ctrl.Result{}, err
 		}
 	}
+	vaultClients.remove(client.ObjectKeyFromObject(obj))
 	return ctrl.Result{}, nil
 }
 
+// syncVaultToken lets the client registry drop a cached root token once the
+// token Secret changed. The VaultServer owns that Secret, so any change to it
+// triggers this reconcile.
+func (r *VaultServerReconciler) syncVaultToken(ctx context.Context, obj *v1alpha1.VaultServer) error {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, vaultTokenSecret(client.ObjectKeyFromObject(obj)), secret)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	vaultClients.syncToken(client.ObjectKeyFromObject(obj), obj.UID, secret.ResourceVersion)
+	return nil
+}
+
 func (r *VaultServerReconciler) cleanup(ctx context.Context, obj *v1alpha1.VaultServer) error {
 	// Add cleanup logic here
 	// For example: revoke tokens, delete external resources, etc.
@@ -259,9 +287,9 @@ func buildURL(config v1alpha1.VaultServerConfig) string {
 
 	// Use HTTPS if TLS is configured, otherwise HTTP
 	scheme := "http"
-	// if spec.Server.TLS != nil && *spec.Server.TLS {
-	// 	scheme = "https"
-	// }
+	if config.TLS != nil {
+		scheme = "https"
+	}
 	b.WriteString(scheme)
 	b.WriteString("://")
 	b.WriteString(config.ServiceName)
@@ -279,6 +307,39 @@ func buildURL(config v1alpha1.VaultServerConfig) string {
 	return b.String()
 }
 
+// checkAuditDevices sets the AuditDeviceEnabled condition, warning when Vault
+// serves requests without any audit device. It needs the root token stored at
+// initialization and leaves the condition untouched without it.
+func (r *VaultServerReconciler) checkAuditDevices(ctx context.Context, obj *v1alpha1.VaultServer, vaultClient cvault.VaultClientI) {
+	logger := log.FromContext(ctx)
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, client.ObjectKey{Name: obj.Name + "-secret", Namespace: obj.Namespace}, secret)
+	if err != nil || len(secret.Data["root_token"]) == 0 {
+		return
+	}
+
+	devices, err := cvault.NewAuditOperator(vaultClient).ListDevices(ctx, string(secret.Data["root_token"]))
+	if err != nil {
+		logger.Error(err, "Failed to list audit devices")
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               v1alpha1.ConditionAuditDeviceEnabled,
+		Status:             metav1.ConditionTrue,
+		Reason:             v1alpha1.ReasonAuditDevicesFound,
+		Message:            fmt.Sprintf("%d audit device(s) enabled", len(devices)),
+		ObservedGeneration: obj.Generation,
+	}
+	if len(devices) == 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = v1alpha1.ReasonNoAuditDevice
+		condition.Message = "No audit device is enabled, requests to Vault are not audited"
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, condition)
+}
+
 func (r *VaultServerReconciler) getUnsealKeys(ctx context.Context, obj *v1alpha1.VaultServer) ([]interface{}, error) {
 	secret := &corev1.Secret{}
 	err := r.Get(ctx, client.ObjectKey{
@@ -381,6 +442,9 @@ func (r *VaultServerReconciler) updateStatus(ctx context.Context, obj *v1alpha1.
 		latest.Status.Phase = string(phase)
 		latest.Status.Message = message
 		latest.Status.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		if audit := meta.FindStatusCondition(obj.Status.Conditions, v1alpha1.ConditionAuditDeviceEnabled); audit != nil {
+			meta.SetStatusCondition(&latest.Status.Conditions, *audit)
+		}
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -405,46 +469,6 @@ func (r *VaultServerReconciler) handleError(ctx context.Context, obj *v1alpha1.V
 	return r.updateStatus(ctx, obj, PhaseDataNotValidated, err.Error(), errorRequeueTime)
 }
 
-func getVaultOpClient(ctx context.Context, name string, namespace string, reqNamespace string, client client.Client) (*VaultOperatorClient, error) {
-
-	vaultOpSearch := types.NamespacedName{
-		Name:      name,
-		Namespace: namespace,
-	}
-
-	if vaultOpSearch.Namespace == "" {
-		vaultOpSearch.Namespace = reqNamespace
-	}
-
-	vaultOpInstance := &v1alpha1.VaultServer{}
-	if err := client.Get(ctx, vaultOpSearch, vaultOpInstance); err != nil {
-		return nil, fmt.Errorf("failed to get vault operator instance: %v", err)
-	}
-
-	url := buildURL(vaultOpInstance.Spec.Server)
-
-	// Build vault client
-	// endpoint := buildURL(vaultObj.Spec)
-	vaultClient, err := cvault.GetClient(url, cvault.WithTimeout(5))
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect with vault: %v", err)
-	}
-
-	vaultOpSearch.Name += "-secret"
-	vaultToken := &corev1.Secret{}
-	if err := client.Get(ctx, vaultOpSearch, vaultToken); err != nil {
-		return nil, err
-	}
-
-	return &VaultOperatorClient{
-		Name:      vaultOpInstance.Name,
-		Namespace: vaultOpInstance.Namespace,
-		Client:    vaultClient,
-		Token:     string(vaultToken.Data["root_token"]),
-		Endpoint:  url,
-	}, nil
-}
-
 // vaultError is a custom error type that includes phase information
 type vaultError struct {
 	phase   Phase
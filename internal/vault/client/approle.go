@@ -3,43 +3,355 @@ package cvault
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
-	vapi "github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type AppRoleOperator struct {
-	endPoint string
-	client   VaultClientI
+	client VaultClientI
 }
 
-func NewAppRoleOperator(client VaultClientI, ep string) *AppRoleOperator {
-	return &AppRoleOperator{client: client, endPoint: ep}
+func NewAppRoleOperator(client VaultClientI) *AppRoleOperator {
+	return &AppRoleOperator{client: client}
 }
 
-func (uo *AppRoleOperator) CreateorUpdateAppRole(ctx context.Context, mountPath string, roleName string, secretIDTTL int, policies []string, token string) error {
-	_, err := uo.client.CreateAppRoleService(ctx, roleName, strconv.Itoa(secretIDTTL), policies, vault.WithMountPath(mountPath), vault.WithToken(token))
+// AppRoleConfig is the role configuration managed by the operator. Durations
+// are in seconds and an empty RoleID keeps the role_id generated by Vault.
+type AppRoleConfig struct {
+	RoleID               string
+	BindSecretID         bool
+	SecretIDTTL          int64
+	SecretIDNumUses      int64
+	SecretIDBoundCIDRs   []string
+	TokenPolicies        []string
+	TokenTTL             int64
+	TokenMaxTTL          int64
+	TokenExplicitMaxTTL  int64
+	TokenPeriod          int64
+	TokenBoundCIDRs      []string
+	TokenNumUses         int64
+	TokenType            string
+	TokenNoDefaultPolicy bool
+}
+
+// ReadAppRole returns the configuration of the role, or nil when it does not exist.
+func (ao *AppRoleOperator) ReadAppRole(ctx context.Context, mountPath string, roleName string, token string) (*AppRoleConfig, error) {
+	resp, err := ao.client.Read(ctx, fmt.Sprintf("auth/%s/role/%s", mountPath, roleName), vault.WithToken(token))
 	if err != nil {
-		return err
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
 	}
-	return nil
+
+	roleID, err := ao.GetRoleId(ctx, roleName, mountPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &AppRoleConfig{
+		RoleID:             roleID,
+		SecretIDBoundCIDRs: stringsField(resp.Data, "secret_id_bound_cidrs"),
+		TokenPolicies:      stringsField(resp.Data, "token_policies"),
+		TokenBoundCIDRs:    stringsField(resp.Data, "token_bound_cidrs"),
+	}
+	config.BindSecretID, _ = resp.Data["bind_secret_id"].(bool)
+	config.TokenNoDefaultPolicy, _ = resp.Data["token_no_default_policy"].(bool)
+	config.TokenType, _ = resp.Data["token_type"].(string)
+
+	numbers := map[string]*int64{
+		"secret_id_ttl":          &config.SecretIDTTL,
+		"secret_id_num_uses":     &config.SecretIDNumUses,
+		"token_ttl":              &config.TokenTTL,
+		"token_max_ttl":          &config.TokenMaxTTL,
+		"token_explicit_max_ttl": &config.TokenExplicitMaxTTL,
+		"token_period":           &config.TokenPeriod,
+		"token_num_uses":         &config.TokenNumUses,
+	}
+	for key, target := range numbers {
+		if *target, err = int64Field(resp.Data, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
 }
 
-func (uo *AppRoleOperator) GenerateAppRoleSecretID(ctx context.Context, path string, roleName string, token string) (string, error) {
+// EnsureAppRole writes the role when it is missing or differs from desired and
+// reports whether a write happened. The raw write is used because the typed
+// request drops false and zero values, which could then never be reset.
+func (ao *AppRoleOperator) EnsureAppRole(ctx context.Context, mountPath string, roleName string, desired AppRoleConfig, token string) (bool, error) {
+	logger := log.FromContext(ctx)
 
-	secret, err := uo.client.WriteAppRoleWithContext(ctx, path, roleName, nil, uo.endPoint, token)
+	current, err := ao.ReadAppRole(ctx, mountPath, roleName, token)
 	if err != nil {
-		return "", err
+		return false, err
+	}
+
+	if current != nil && appRoleConfigEqual(*current, desired) {
+		return false, nil
+	}
+
+	data := map[string]interface{}{
+		"bind_secret_id":          desired.BindSecretID,
+		"secret_id_ttl":           desired.SecretIDTTL,
+		"secret_id_num_uses":      desired.SecretIDNumUses,
+		"secret_id_bound_cidrs":   NonNilStrings(desired.SecretIDBoundCIDRs),
+		"token_policies":          NonNilStrings(desired.TokenPolicies),
+		"token_ttl":               desired.TokenTTL,
+		"token_max_ttl":           desired.TokenMaxTTL,
+		"token_explicit_max_ttl":  desired.TokenExplicitMaxTTL,
+		"token_period":            desired.TokenPeriod,
+		"token_bound_cidrs":       NonNilStrings(desired.TokenBoundCIDRs),
+		"token_num_uses":          desired.TokenNumUses,
+		"token_type":              defaultTokenType(desired.TokenType),
+		"token_no_default_policy": desired.TokenNoDefaultPolicy,
+	}
+	if desired.RoleID != "" {
+		data["role_id"] = desired.RoleID
+	}
+
+	_, err = ao.client.Write(ctx, fmt.Sprintf("auth/%s/role/%s", mountPath, roleName), data, vault.WithToken(token))
+	if err != nil {
+		return false, err
+	}
+
+	logger.Info("approle written", "mount", mountPath, "role", roleName, "created", current == nil)
+	return true, nil
+}
+
+func appRoleConfigEqual(current AppRoleConfig, desired AppRoleConfig) bool {
+	if desired.RoleID != "" && current.RoleID != desired.RoleID {
+		return false
 	}
 
-	// Extract SecretID
-	if secret != nil && secret.Data != nil {
-		return secret.Data["secret_id"].(string), nil
-	} else {
-		return "", fmt.Errorf("no secret data found")
+	return current.BindSecretID == desired.BindSecretID &&
+		current.SecretIDTTL == desired.SecretIDTTL &&
+		current.SecretIDNumUses == desired.SecretIDNumUses &&
+		sameStrings(current.SecretIDBoundCIDRs, desired.SecretIDBoundCIDRs) &&
+		sameStrings(current.TokenPolicies, desired.TokenPolicies) &&
+		current.TokenTTL == desired.TokenTTL &&
+		current.TokenMaxTTL == desired.TokenMaxTTL &&
+		current.TokenExplicitMaxTTL == desired.TokenExplicitMaxTTL &&
+		current.TokenPeriod == desired.TokenPeriod &&
+		sameStrings(current.TokenBoundCIDRs, desired.TokenBoundCIDRs) &&
+		current.TokenNumUses == desired.TokenNumUses &&
+		defaultTokenType(current.TokenType) == defaultTokenType(desired.TokenType) &&
+		current.TokenNoDefaultPolicy == desired.TokenNoDefaultPolicy
+}
+
+func defaultTokenType(tokenType string) string {
+	if tokenType == "" {
+		return "default"
 	}
+	return tokenType
+}
+
+// sameStrings compares two lists ignoring order.
+func sameStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// NonNilStrings returns an empty list for nil, so that a raw write sends []
+// rather than null, which Vault ignores.
+func NonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func stringsField(data map[string]interface{}, key string) []string {
+	values, _ := data[key].([]interface{})
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// ParseDurationSeconds converts a duration in the Vault format, either plain
+// seconds like "3600" or a Go duration like "1h", into seconds.
+func ParseDurationSeconds(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return seconds, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+	}
+	return int64(duration.Seconds()), nil
+}
+
+// AppRoleSecretID is a freshly issued secret-id. TTL is in seconds, zero
+// meaning the secret-id does not expire.
+type AppRoleSecretID struct {
+	SecretID string
+	Accessor string
+	TTL      int64
+
+	// WrappingToken replaces SecretID for response-wrapped secret-ids, valid
+	// until WrappingExpiration.
+	WrappingToken      string
+	WrappingExpiration time.Time
+}
+
+// AppRoleSecretIDInfo is what Vault reports about an issued secret-id.
+// ExpirationTime is zero for secret-ids without a TTL.
+type AppRoleSecretIDInfo struct {
+	CreationTime   time.Time
+	ExpirationTime time.Time
+}
+
+// GenerateAppRoleSecretID issues a secret-id. With a positive wrapTTL the
+// secret-id is response-wrapped and only the wrapping token is returned.
+func (uo *AppRoleOperator) GenerateAppRoleSecretID(ctx context.Context, path string, roleName string, wrapTTL time.Duration, token string) (*AppRoleSecretID, error) {
+	if wrapTTL > 0 {
+		return uo.generateWrappedSecretID(ctx, path, roleName, wrapTTL, token)
+	}
+
+	secret, err := uo.client.Write(ctx, fmt.Sprintf("auth/%s/role/%s/secret-id", path, roleName), nil, vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("no secret data found")
+	}
+
+	secretID, _ := secret.Data["secret_id"].(string)
+	accessor, _ := secret.Data["secret_id_accessor"].(string)
+	if secretID == "" || accessor == "" {
+		return nil, fmt.Errorf("secret-id or accessor missing from response")
+	}
+
+	ttl, err := int64Field(secret.Data, "secret_id_ttl")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppRoleSecretID{SecretID: secretID, Accessor: accessor, TTL: ttl}, nil
+}
+
+// generateWrappedSecretID reads the secret-id TTL back through its accessor,
+// which Vault reports as the wrapped accessor, since the response itself is
+// sealed in the wrapping token.
+func (uo *AppRoleOperator) generateWrappedSecretID(ctx context.Context, path string, roleName string, wrapTTL time.Duration, token string) (*AppRoleSecretID, error) {
+	resp, err := uo.client.Write(ctx, fmt.Sprintf("auth/%s/role/%s/secret-id", path, roleName), nil,
+		vault.WithToken(token), vault.WithResponseWrapping(wrapTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.WrapInfo == nil || resp.WrapInfo.Token == "" || resp.WrapInfo.WrappedAccessor == "" {
+		return nil, fmt.Errorf("no wrapping info found")
+	}
+
+	secretID := &AppRoleSecretID{
+		Accessor:           resp.WrapInfo.WrappedAccessor,
+		WrappingToken:      resp.WrapInfo.Token,
+		WrappingExpiration: resp.WrapInfo.CreationTime.Add(time.Duration(resp.WrapInfo.TTL) * time.Second),
+	}
+
+	info, err := uo.LookupSecretIDAccessor(ctx, path, roleName, secretID.Accessor, token)
+	if err != nil {
+		return nil, err
+	}
+	if info != nil && !info.ExpirationTime.IsZero() {
+		secretID.TTL = int64(info.ExpirationTime.Sub(info.CreationTime).Seconds())
+	}
+
+	return secretID, nil
+}
+
+// LookupWrappingToken tells whether a wrapping token is still valid, that is
+// neither unwrapped nor expired.
+func (uo *AppRoleOperator) LookupWrappingToken(ctx context.Context, wrappingToken string, token string) (bool, error) {
+	_, err := uo.client.Write(ctx, "sys/wrapping/lookup", map[string]interface{}{"token": wrappingToken}, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 400) || vault.IsErrorStatus(err, 404) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LookupSecretIDAccessor returns the secret-id behind accessor, or nil when it
+// no longer exists because it expired or was destroyed.
+func (uo *AppRoleOperator) LookupSecretIDAccessor(ctx context.Context, mountPath string, roleName string, accessor string, token string) (*AppRoleSecretIDInfo, error) {
+	resp, err := uo.client.Write(ctx, fmt.Sprintf("auth/%s/role/%s/secret-id-accessor/lookup", mountPath, roleName),
+		map[string]interface{}{"secret_id_accessor": accessor}, vault.WithToken(token))
+	if err != nil {
+		if isSecretIDAccessorNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp == nil || len(resp.Data) == 0 {
+		return nil, nil
+	}
+
+	info := &AppRoleSecretIDInfo{}
+	info.CreationTime = timeField(resp.Data, "creation_time")
+	info.ExpirationTime = timeField(resp.Data, "expiration_time")
+	return info, nil
+}
+
+// DestroySecretIDAccessor destroys the secret-id behind accessor. Accessors
+// that are already gone are not an error.
+func (uo *AppRoleOperator) DestroySecretIDAccessor(ctx context.Context, mountPath string, roleName string, accessor string, token string) error {
+	logger := log.FromContext(ctx)
+
+	_, err := uo.client.Write(ctx, fmt.Sprintf("auth/%s/role/%s/secret-id-accessor/destroy", mountPath, roleName),
+		map[string]interface{}{"secret_id_accessor": accessor}, vault.WithToken(token))
+	if err != nil && !isSecretIDAccessorNotFound(err) {
+		return err
+	}
+
+	logger.Info("secret-id destroyed", "mount", mountPath, "role", roleName, "accessor", accessor)
+	return nil
+}
+
+// isSecretIDAccessorNotFound covers both the 404 of recent Vault versions and
+// the error older versions return for unknown accessors.
+func isSecretIDAccessorNotFound(err error) bool {
+	if vault.IsErrorStatus(err, 404) {
+		return true
+	}
+	return strings.Contains(err.Error(), "failed to find accessor entry")
+}
+
+// timeField parses an RFC 3339 timestamp, Vault reporting the zero time for
+// secret-ids without expiration.
+func timeField(data map[string]interface{}, key string) time.Time {
+	value, _ := data[key].(string)
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || t.Year() <= 1 {
+		return time.Time{}
+	}
+	return t
 }
 
 func (ap *AppRoleOperator) GetRoleId(ctx context.Context, roleName string, mountPath string, token string) (string, error) {
@@ -71,28 +383,6 @@ func (ao *AppRoleOperator) DeleteAppRole(ctx context.Context, mountPath string,
 	return nil
 }
 
-func (vc *VaultClient) CreateAppRoleService(ctx context.Context, roleName string, secretIDTTL string, policies []string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
-	return vc.Auth.AppRoleWriteRole(ctx, roleName, schema.AppRoleWriteRoleRequest{
-		SecretIdTtl: secretIDTTL,
-		Policies:    policies,
-	}, options...)
-}
-
-func (vc *VaultClient) WriteAppRoleWithContext(ctx context.Context, path string, roleName string, data map[string]interface{}, ep string, token string) (*vapi.Secret, error) {
-	config := vapi.DefaultConfig()
-	config.Address = ep
-
-	client, err := vapi.NewClient(config)
-	if err != nil {
-		return nil, err
-	}
-
-	client.SetToken(token)
-	request := fmt.Sprintf("auth/%s/role/%s/secret-id", path, roleName)
-
-	return client.Logical().WriteWithContext(ctx, request, nil)
-}
-
 func (vc *VaultClient) GetAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[schema.AppRoleReadRoleIdResponse], error) {
 	return vc.Auth.AppRoleReadRoleId(ctx, roleName, options...)
 }
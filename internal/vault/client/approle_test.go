@@ -2,16 +2,15 @@ package cvault
 
 import (
 	"context"
+	"encoding/json"
+	"testing"
+	"time"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
-	vapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
 )
 
-func (mc *MockVaultClient) CreateAppRoleService(ctx context.Context, roleName string, secretIDTTL string, policies []string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
-	return &vault.Response[map[string]interface{}]{}, nil
-}
-
 func (mc *MockVaultClient) GetAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[schema.AppRoleReadRoleIdResponse], error) {
 	return &vault.Response[schema.AppRoleReadRoleIdResponse]{}, nil
 }
@@ -20,6 +19,112 @@ func (mc *MockVaultClient) DeleteAppRole(ctx context.Context, roleName string, o
 	return &vault.Response[map[string]interface{}]{}, nil
 }
 
-func (mc *MockVaultClient) WriteAppRoleWithContext(ctx context.Context, path string, roleName string, data map[string]interface{}, ep string, token string) (*vapi.Secret, error) {
-	return nil, nil
+func TestAppRoleGenerateSecretID(t *testing.T) {
+	op := NewAppRoleOperator(&MockVaultClient{writeResponses: map[string]*vault.Response[map[string]interface{}]{
+		"auth/approle/role/app/secret-id": {Data: map[string]interface{}{
+			"secret_id":          "secret-id",
+			"secret_id_accessor": "accessor",
+			"secret_id_ttl":      json.Number("3600"),
+		}},
+	}})
+
+	secretID, err := op.GenerateAppRoleSecretID(context.Background(), "approle", "app", 0, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, &AppRoleSecretID{SecretID: "secret-id", Accessor: "accessor", TTL: 3600}, secretID)
+}
+
+func TestAppRoleSecretIDAccessor(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewAppRoleOperator(client)
+
+	// the mock answers writes without data, as Vault does for unknown accessors
+	info, err := op.LookupSecretIDAccessor(context.Background(), "approle", "app", "accessor", "token")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	err = op.DestroySecretIDAccessor(context.Background(), "approle", "app", "accessor", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "accessor", client.writes["auth/approle/role/app/secret-id-accessor/destroy"]["secret_id_accessor"])
+}
+
+func TestTimeField(t *testing.T) {
+	created := timeField(map[string]interface{}{"t": "2025-01-02T03:04:05.123456Z"}, "t")
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC), created)
+
+	// secret-ids without a ttl report the zero time
+	assert.True(t, timeField(map[string]interface{}{"t": "0001-01-01T00:00:00Z"}, "t").IsZero())
+	assert.True(t, timeField(map[string]interface{}{}, "t").IsZero())
+}
+
+func TestAppRoleEnsureRole(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewAppRoleOperator(client)
+	desired := AppRoleConfig{
+		BindSecretID:  false,
+		SecretIDTTL:   3600,
+		TokenPolicies: []string{"b", "a"},
+		TokenTTL:      600,
+	}
+
+	changed, err := op.EnsureAppRole(context.Background(), "approle", "app", desired, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	written := client.writes["auth/approle/role/app"]
+	assert.Equal(t, false, written["bind_secret_id"])
+	assert.Equal(t, "default", written["token_type"])
+	assert.Equal(t, []string{}, written["token_bound_cidrs"])
+	assert.NotContains(t, written, "role_id")
+
+	// Vault answers with numbers, unordered lists and the default token type
+	client.reads = map[string]*vault.Response[map[string]interface{}]{
+		"auth/approle/role/app": {Data: map[string]interface{}{
+			"bind_secret_id": false,
+			"secret_id_ttl":  json.Number("3600"),
+			"token_policies": []interface{}{"a", "b"},
+			"token_ttl":      json.Number("600"),
+			"token_type":     "default",
+		}},
+	}
+	delete(client.writes, "auth/approle/role/app")
+
+	changed, err = op.EnsureAppRole(context.Background(), "approle", "app", desired, "token")
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.NotContains(t, client.writes, "auth/approle/role/app")
+
+	// drift
+	client.reads["auth/approle/role/app"].Data["token_ttl"] = json.Number("60")
+	changed, err = op.EnsureAppRole(context.Background(), "approle", "app", desired, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+}
+
+func TestParseDurationSeconds(t *testing.T) {
+	for value, expected := range map[string]int64{"": 0, "3600": 3600, "1h": 3600, "90m": 5400} {
+		seconds, err := ParseDurationSeconds(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, seconds, value)
+	}
+
+	_, err := ParseDurationSeconds("soon")
+	assert.Error(t, err)
+}
+
+func TestAppRoleGenerateWrappedSecretID(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := &MockVaultClient{wrapInfo: &vault.ResponseWrapInfo{
+		Token:           "hvs.wrapping",
+		TTL:             300,
+		CreationTime:    created,
+		WrappedAccessor: "accessor",
+	}}
+	op := NewAppRoleOperator(client)
+
+	secretID, err := op.GenerateAppRoleSecretID(context.Background(), "approle", "app", 5*time.Minute, "token")
+	assert.NoError(t, err)
+	assert.Empty(t, secretID.SecretID)
+	assert.Equal(t, "hvs.wrapping", secretID.WrappingToken)
+	assert.Equal(t, "accessor", secretID.Accessor)
+	assert.Equal(t, created.Add(5*time.Minute), secretID.WrappingExpiration)
+	assert.Contains(t, client.writes, "auth/approle/role/app/secret-id")
 }
@@ -0,0 +1,90 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// AuditDevice is an enabled audit device as listed by sys/audit.
+type AuditDevice struct {
+	Type        string
+	Description string
+	Local       bool
+	Options     map[string]string
+}
+
+type AuditOperator struct {
+	client VaultClientI
+}
+
+func NewAuditOperator(client VaultClientI) *AuditOperator {
+	return &AuditOperator{client: client}
+}
+
+// ListDevices returns the enabled audit devices keyed by path, without the
+// trailing slash.
+func (ao *AuditOperator) ListDevices(ctx context.Context, token string) (map[string]AuditDevice, error) {
+	resp, err := ao.client.AuditingListEnabledDevices(ctx, vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	devices := map[string]AuditDevice{}
+	if resp == nil {
+		return devices, nil
+	}
+
+	for path, entry := range resp.Data {
+		data, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		device := AuditDevice{Options: map[string]string{}}
+		device.Type, _ = data["type"].(string)
+		device.Description, _ = data["description"].(string)
+		device.Local, _ = data["local"].(bool)
+		options, _ := data["options"].(map[string]interface{})
+		for k, v := range options {
+			device.Options[k] = fmt.Sprint(v)
+		}
+		devices[strings.TrimSuffix(path, "/")] = device
+	}
+
+	return devices, nil
+}
+
+// EnableDevice enables an audit device at path. Vault cannot change the
+// options of an enabled device, it has to be disabled first.
+func (ao *AuditOperator) EnableDevice(ctx context.Context, path string, request schema.AuditingEnableDeviceRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Enabling audit device", "path", path, "type", request.Type)
+
+	_, err := ao.client.AuditingEnableDevice(ctx, path, request, vault.WithToken(token))
+	return err
+}
+
+func (ao *AuditOperator) DisableDevice(ctx context.Context, path string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Disabling audit device", "path", path)
+
+	_, err := ao.client.AuditingDisableDevice(ctx, path, vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) AuditingListEnabledDevices(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.System.AuditingListEnabledDevices(ctx, options...)
+}
+
+func (vc *VaultClient) AuditingEnableDevice(ctx context.Context, path string, request schema.AuditingEnableDeviceRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.System.AuditingEnableDevice(ctx, path, request, options...)
+}
+
+func (vc *VaultClient) AuditingDisableDevice(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.System.AuditingDisableDevice(ctx, path, options...)
+}
@@ -0,0 +1,64 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) AuditingListEnabledDevices(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	data := map[string]interface{}{}
+	for path, device := range mc.auditDevices {
+		data[path+"/"] = map[string]interface{}{
+			"type":        device.Type,
+			"description": device.Description,
+			"local":       device.Local,
+			"options":     device.Options,
+		}
+	}
+	return &vault.Response[map[string]interface{}]{Data: data}, nil
+}
+
+func (mc *MockVaultClient) AuditingEnableDevice(ctx context.Context, path string, request schema.AuditingEnableDeviceRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if mc.auditDevices == nil {
+		mc.auditDevices = map[string]schema.AuditingEnableDeviceRequest{}
+	}
+	mc.auditDevices[path] = request
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) AuditingDisableDevice(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.auditDevices, path)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestAuditDevices(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewAuditOperator(client)
+
+	devices, err := op.ListDevices(ctx, "token")
+	assert.NoError(t, err)
+	assert.Empty(t, devices)
+
+	err = op.EnableDevice(ctx, "file", schema.AuditingEnableDeviceRequest{
+		Type:    "file",
+		Options: map[string]interface{}{"file_path": "stdout", "log_raw": "false"},
+	}, "token")
+	assert.NoError(t, err)
+
+	devices, err = op.ListDevices(ctx, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, AuditDevice{
+		Type:    "file",
+		Options: map[string]string{"file_path": "stdout", "log_raw": "false"},
+	}, devices["file"])
+
+	assert.NoError(t, op.DisableDevice(ctx, "file", "token"))
+	devices, err = op.ListDevices(ctx, "token")
+	assert.NoError(t, err)
+	assert.Empty(t, devices)
+}
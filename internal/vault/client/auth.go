@@ -2,9 +2,12 @@ package cvault
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type AuthOperator struct {
@@ -15,13 +18,18 @@ func NewAuthOperator(client VaultClientI) *AuthOperator {
 	return &AuthOperator{client: client}
 }
 
+// EnableAuthMethod enables method at path. An existing mount of the same type
+// is left untouched, one of another type yields a *MountTypeMismatchError.
 func (ao *AuthOperator) EnableAuthMethod(path string, method string, token string) error {
-	ok, err := ao.IsAuthMethodEnabled(ao.client, path, token)
+	mType, ok, err := ao.AuthMethodType(path, token)
 	if err != nil {
 		return err
 	}
 
 	if ok {
+		if mType != method {
+			return &MountTypeMismatchError{Path: path, Expected: method, Actual: mType}
+		}
 		return nil
 	}
 
@@ -57,6 +65,52 @@ func (ao *AuthOperator) IsAuthMethodEnabled(client VaultClientI, path string, to
 	return false, nil
 }
 
+// AuthMethodType returns the type of the auth method mounted at path and
+// whether anything is mounted there at all.
+func (ao *AuthOperator) AuthMethodType(path string, token string) (string, bool, error) {
+	resp, err := ao.client.ListAuthMethods(context.Background(), vault.WithToken(token))
+	if err != nil {
+		return "", false, err
+	}
+
+	mType, ok := mountType(resp.Data, path)
+	return mType, ok, nil
+}
+
+// MountAccessor returns the accessor of the auth method mounted at path, as
+// needed by identity aliases.
+func (ao *AuthOperator) MountAccessor(path string, token string) (string, error) {
+	resp, err := ao.client.ListAuthMethods(context.Background(), vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	entry, ok := resp.Data[strings.TrimSuffix(path, "/")+"/"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("auth method %s is not enabled", path)
+	}
+
+	accessor, _ := entry["accessor"].(string)
+	if accessor == "" {
+		return "", fmt.Errorf("auth method %s has no accessor", path)
+	}
+	return accessor, nil
+}
+
+// RemountAuthMethod moves the auth method at from to to, keeping its roles
+// and configuration. It returns the id of the migration Vault runs for it.
+func (ao *AuthOperator) RemountAuthMethod(ctx context.Context, from string, to string, token string) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Remounting auth method", "from", from, "to", to)
+
+	return remount(ctx, ao.client, "auth/"+from, "auth/"+to, token)
+}
+
+// RemountStatus returns the state of a migration started by RemountAuthMethod.
+func (ao *AuthOperator) RemountStatus(ctx context.Context, migrationID string, token string) (string, error) {
+	return remountStatus(ctx, ao.client, migrationID, token)
+}
+
 func (vc *VaultClient) AuthEnableAuthMethod(ctx context.Context, path string, request schema.AuthEnableMethodRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
 	return vc.System.AuthEnableMethod(ctx, path, request, options...)
 }
@@ -2,13 +2,16 @@ package cvault
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func (vc *MockVaultClient) AuthEnableAuthMethod(ctx context.Context, path string, request schema.AuthEnableMethodRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	vc.authEnabled = append(vc.authEnabled, path)
 	return nil, nil
 }
 
@@ -17,7 +20,7 @@ func (vc *MockVaultClient) AuthDisableAuthMethod(ctx context.Context, path strin
 }
 
 func (vc *MockVaultClient) ListAuthMethods(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
-	return &vault.Response[map[string]interface{}]{}, nil
+	return &vault.Response[map[string]interface{}]{Data: vc.mounts}, nil
 }
 
 
@@ -39,4 +42,56 @@ func TestAuthDisabling(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
-}
\ No newline at end of file
+}
+func TestAuthEnablingExistingSameType(t *testing.T) {
+	client := &MockVaultClient{mounts: map[string]interface{}{
+		"my-auth-path/": map[string]interface{}{"type": "kubernetes"},
+	}}
+	authOp := NewAuthOperator(client)
+
+	err := authOp.EnableAuthMethod("my-auth-path", "kubernetes", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, client.authEnabled)
+}
+
+func TestAuthEnablingTypeMismatch(t *testing.T) {
+	client := &MockVaultClient{mounts: map[string]interface{}{
+		"my-auth-path/": map[string]interface{}{"type": "userpass"},
+	}}
+	authOp := NewAuthOperator(client)
+
+	err := authOp.EnableAuthMethod("my-auth-path", "kubernetes", "token")
+	var mismatch *MountTypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "userpass", mismatch.Actual)
+	assert.Equal(t, "kubernetes", mismatch.Expected)
+	assert.Empty(t, client.authEnabled)
+}
+
+func TestAuthRemount(t *testing.T) {
+	client := &MockVaultClient{migrationStatus: MigrationSuccess}
+	authOp := NewAuthOperator(client)
+
+	id, err := authOp.RemountAuthMethod(context.Background(), "old", "new", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "migration-1", id)
+	assert.Equal(t, []schema.RemountRequest{{From: "auth/old", To: "auth/new"}}, client.remounts)
+
+	status, err := authOp.RemountStatus(context.Background(), id, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, MigrationSuccess, status)
+}
+
+func TestAuthMountAccessor(t *testing.T) {
+	client := &MockVaultClient{mounts: map[string]interface{}{
+		"userpass/": map[string]interface{}{"type": "userpass", "accessor": "auth_userpass_1234"},
+	}}
+	op := NewAuthOperator(client)
+
+	accessor, err := op.MountAccessor("userpass", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "auth_userpass_1234", accessor)
+
+	_, err = op.MountAccessor("ldap", "token")
+	assert.Error(t, err)
+}
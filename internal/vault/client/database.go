@@ -0,0 +1,172 @@
+package cvault
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type DatabaseOperator struct {
+	client VaultClientI
+}
+
+func NewDatabaseOperator(client VaultClientI) *DatabaseOperator {
+	return &DatabaseOperator{client: client}
+}
+
+// ConfigureConnection writes a connection with a raw request. The plugin
+// specific fields (connection_url, username, password, ...) are not part of
+// the typed request, and verify_connection=false must not be dropped.
+func (do *DatabaseOperator) ConfigureConnection(ctx context.Context, mountPath string, name string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database connection configuration", "mount", mountPath, "connection", name)
+
+	_, err := do.client.Write(ctx, mountPath+"/config/"+name, data, vault.WithToken(token))
+	return err
+}
+
+// EnsureConnection writes the connection when it is missing, differs from
+// settings or updatePassword is set, and reports whether it had to. Every
+// write makes Vault open a connection to the database, so an unchanged one is
+// left alone. The password is never returned by Vault and is only sent for a
+// new connection or when updatePassword is set; Vault keeps the current one
+// otherwise.
+func (do *DatabaseOperator) EnsureConnection(ctx context.Context, mountPath string, name string, password string, updatePassword bool, settings map[string]interface{}, token string) (bool, error) {
+	current, err := do.ReadConnection(ctx, mountPath, name, token)
+	if err != nil {
+		return false, err
+	}
+	if current != nil && !updatePassword && connectionMatches(current, settings) {
+		return false, nil
+	}
+
+	data := map[string]interface{}{}
+	for key, value := range settings {
+		data[key] = value
+	}
+	if current == nil || updatePassword {
+		data["password"] = password
+	}
+
+	return true, do.ConfigureConnection(ctx, mountPath, name, data, token)
+}
+
+// ReadConnection returns the connection config, or nil when it does not exist.
+func (do *DatabaseOperator) ReadConnection(ctx context.Context, mountPath string, name string, token string) (map[string]interface{}, error) {
+	resp, err := do.client.Read(ctx, mountPath+"/config/"+name, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+	return resp.Data, nil
+}
+
+func (do *DatabaseOperator) DeleteConnection(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database connection deletion", "mount", mountPath, "connection", name)
+
+	_, err := do.client.DatabaseDeleteConnectionConfiguration(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// RotateRootCredentials rotates the password of the connection user. Only
+// Vault knows the new password afterwards.
+func (do *DatabaseOperator) RotateRootCredentials(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Rotating database root credentials", "mount", mountPath, "connection", name)
+
+	_, err := do.client.DatabaseRotateRootCredentials(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (do *DatabaseOperator) CreateOrUpdateRole(ctx context.Context, mountPath string, name string, request schema.DatabaseWriteRoleRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database role creation or update", "mount", mountPath, "role", name)
+
+	_, err := do.client.DatabaseWriteRole(ctx, name, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (do *DatabaseOperator) DeleteRole(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database role deletion", "mount", mountPath, "role", name)
+
+	_, err := do.client.DatabaseDeleteRole(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (do *DatabaseOperator) CreateOrUpdateStaticRole(ctx context.Context, mountPath string, name string, request schema.DatabaseWriteStaticRoleRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database static role creation or update", "mount", mountPath, "role", name)
+
+	_, err := do.client.DatabaseWriteStaticRole(ctx, name, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (do *DatabaseOperator) DeleteStaticRole(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting database static role deletion", "mount", mountPath, "role", name)
+
+	_, err := do.client.DatabaseDeleteStaticRole(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// connectionMatches compares the settings of a connection write with the
+// config read back. Vault returns the plugin specific fields under
+// connection_details and the rotation statements under another name;
+// verify_connection only applies to the write itself.
+func connectionMatches(current map[string]interface{}, settings map[string]interface{}) bool {
+	details, _ := current["connection_details"].(map[string]interface{})
+	for key, want := range settings {
+		source, readKey := current, key
+		switch key {
+		case "verify_connection":
+			continue
+		case "connection_url", "username":
+			source = details
+		case "root_rotation_statements":
+			readKey = "root_credentials_rotate_statements"
+		}
+
+		if list, ok := want.([]string); ok {
+			if !slices.Equal(stringsField(source, readKey), list) {
+				return false
+			}
+		} else if source[readKey] != want {
+			return false
+		}
+	}
+	return true
+}
+
+func (vc *VaultClient) DatabaseDeleteConnectionConfiguration(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseDeleteConnectionConfiguration(ctx, name, options...)
+}
+
+func (vc *VaultClient) DatabaseRotateRootCredentials(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseRotateRootCredentials(ctx, name, options...)
+}
+
+func (vc *VaultClient) DatabaseWriteRole(ctx context.Context, name string, request schema.DatabaseWriteRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseWriteRole(ctx, name, request, options...)
+}
+
+func (vc *VaultClient) DatabaseDeleteRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseDeleteRole(ctx, name, options...)
+}
+
+func (vc *VaultClient) DatabaseWriteStaticRole(ctx context.Context, name string, request schema.DatabaseWriteStaticRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseWriteStaticRole(ctx, name, request, options...)
+}
+
+func (vc *VaultClient) DatabaseDeleteStaticRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.DatabaseDeleteStaticRole(ctx, name, options...)
+}
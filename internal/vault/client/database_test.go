@@ -0,0 +1,125 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) DatabaseDeleteConnectionConfiguration(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) DatabaseRotateRootCredentials(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.dbRotations = append(mc.dbRotations, name)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) DatabaseWriteRole(ctx context.Context, name string, request schema.DatabaseWriteRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.dbRole = request
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) DatabaseDeleteRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) DatabaseWriteStaticRole(ctx context.Context, name string, request schema.DatabaseWriteStaticRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.dbStaticRole = request
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) DatabaseDeleteStaticRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestDatabaseConfigureConnection(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewDatabaseOperator(client)
+
+	err := op.ConfigureConnection(context.Background(), "database", "app", map[string]interface{}{
+		"plugin_name":       "postgresql-database-plugin",
+		"verify_connection": false,
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, false, client.writes["database/config/app"]["verify_connection"])
+
+	err = op.RotateRootCredentials(context.Background(), "database", "app", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app"}, client.dbRotations)
+
+	err = op.DeleteConnection(context.Background(), "database", "app", "token")
+	assert.NoError(t, err)
+}
+
+func TestDatabaseEnsureConnection(t *testing.T) {
+	settings := map[string]interface{}{
+		"plugin_name":       "postgresql-database-plugin",
+		"connection_url":    "postgresql://{{username}}:{{password}}@db:5432/app",
+		"username":          "vault",
+		"allowed_roles":     []string{"readonly"},
+		"verify_connection": false,
+	}
+	client := &MockVaultClient{}
+	op := NewDatabaseOperator(client)
+
+	changed, err := op.EnsureConnection(context.Background(), "database", "app", "secret", false, settings, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "secret", client.writes["database/config/app"]["password"])
+
+	client = &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"database/config/app": {Data: map[string]interface{}{
+			"plugin_name":   "postgresql-database-plugin",
+			"allowed_roles": []interface{}{"readonly"},
+			"connection_details": map[string]interface{}{
+				"connection_url": "postgresql://{{username}}:{{password}}@db:5432/app",
+				"username":       "vault",
+			},
+		}},
+	}}
+	op = NewDatabaseOperator(client)
+
+	changed, err = op.EnsureConnection(context.Background(), "database", "app", "secret", false, settings, "token")
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, client.writes)
+
+	changed, err = op.EnsureConnection(context.Background(), "database", "app", "rotated", true, settings, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "rotated", client.writes["database/config/app"]["password"])
+
+	settings["allowed_roles"] = []string{"readonly", "readwrite"}
+	client.writes = nil
+	changed, err = op.EnsureConnection(context.Background(), "database", "app", "secret", false, settings, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, client.writes["database/config/app"], "password")
+}
+
+func TestDatabaseRoles(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewDatabaseOperator(client)
+
+	err := op.CreateOrUpdateRole(context.Background(), "database", "readonly", schema.DatabaseWriteRoleRequest{
+		DbName:     "app",
+		DefaultTtl: "1h",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "app", client.dbRole.DbName)
+
+	err = op.CreateOrUpdateStaticRole(context.Background(), "database", "static-app", schema.DatabaseWriteStaticRoleRequest{
+		DbName:         "app",
+		Username:       "static_app",
+		RotationPeriod: "24h",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "static_app", client.dbStaticRole.Username)
+
+	assert.NoError(t, op.DeleteRole(context.Background(), "database", "readonly", "token"))
+	assert.NoError(t, op.DeleteStaticRole(context.Background(), "database", "static-app", "token"))
+}
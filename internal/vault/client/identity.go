@@ -0,0 +1,237 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// IdentityAlias is an entity or group alias read back from Vault.
+type IdentityAlias struct {
+	ID            string
+	Name          string
+	MountAccessor string
+	CanonicalID   string
+}
+
+// IdentityGroup is the subset of a group read back from Vault.
+type IdentityGroup struct {
+	ID    string
+	Type  string
+	Alias *IdentityAlias
+}
+
+type IdentityOperator struct {
+	client VaultClientI
+}
+
+func NewIdentityOperator(client VaultClientI) *IdentityOperator {
+	return &IdentityOperator{client: client}
+}
+
+// ReadEntityID returns the id of the named entity, or an empty string when
+// it does not exist.
+func (io *IdentityOperator) ReadEntityID(ctx context.Context, name string, token string) (string, error) {
+	resp, err := io.client.EntityReadByName(ctx, name, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return "", nil
+		}
+		return "", err
+	}
+	if resp == nil {
+		return "", nil
+	}
+
+	id, _ := resp.Data["id"].(string)
+	return id, nil
+}
+
+// WriteEntity creates or updates the named entity with a raw request, so that
+// an empty policy list or disabled=false are applied as well.
+func (io *IdentityOperator) WriteEntity(ctx context.Context, name string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity entity creation or update", "entity", name)
+
+	_, err := io.client.Write(ctx, "identity/entity/name/"+name, data, vault.WithToken(token))
+	return err
+}
+
+func (io *IdentityOperator) DeleteEntity(ctx context.Context, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity entity deletion", "entity", name)
+
+	_, err := io.client.EntityDeleteByName(ctx, name, vault.WithToken(token))
+	return err
+}
+
+// ReadEntityAlias returns the alias, or nil when it does not exist.
+func (io *IdentityOperator) ReadEntityAlias(ctx context.Context, id string, token string) (*IdentityAlias, error) {
+	resp, err := io.client.EntityReadAliasById(ctx, id, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	return identityAlias(resp.Data), nil
+}
+
+// CreateEntityAlias creates the alias and returns its id.
+func (io *IdentityOperator) CreateEntityAlias(ctx context.Context, request schema.EntityCreateAliasRequest, token string) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity entity alias creation", "alias", request.Name, "accessor", request.MountAccessor)
+
+	resp, err := io.client.EntityCreateAlias(ctx, request, vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	id, _ := resp.Data["id"].(string)
+	return id, nil
+}
+
+// UpdateEntityAlias moves the alias to another entity or changes its metadata.
+// The name and mount accessor of an alias cannot be changed. It uses a raw
+// request, so that empty custom_metadata clears the metadata in Vault.
+func (io *IdentityOperator) UpdateEntityAlias(ctx context.Context, id string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity entity alias update", "id", id)
+
+	_, err := io.client.Write(ctx, "identity/entity-alias/id/"+id, data, vault.WithToken(token))
+	return err
+}
+
+func (io *IdentityOperator) DeleteEntityAlias(ctx context.Context, id string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity entity alias deletion", "id", id)
+
+	_, err := io.client.EntityDeleteAliasById(ctx, id, vault.WithToken(token))
+	return err
+}
+
+// ReadGroup returns the named group, or nil when it does not exist.
+func (io *IdentityOperator) ReadGroup(ctx context.Context, name string, token string) (*IdentityGroup, error) {
+	resp, err := io.client.GroupReadByName(ctx, name, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	group := &IdentityGroup{}
+	group.ID, _ = resp.Data["id"].(string)
+	group.Type, _ = resp.Data["type"].(string)
+	if alias, ok := resp.Data["alias"].(map[string]interface{}); ok && alias["id"] != nil {
+		group.Alias = identityAlias(alias)
+	}
+
+	return group, nil
+}
+
+// WriteGroup creates or updates the named group with a raw request, so that
+// removing the last member or policy is applied as well.
+func (io *IdentityOperator) WriteGroup(ctx context.Context, name string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity group creation or update", "group", name)
+
+	_, err := io.client.Write(ctx, "identity/group/name/"+name, data, vault.WithToken(token))
+	return err
+}
+
+func (io *IdentityOperator) DeleteGroup(ctx context.Context, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity group deletion", "group", name)
+
+	_, err := io.client.GroupDeleteByName(ctx, name, vault.WithToken(token))
+	return err
+}
+
+// CreateGroupAlias creates the alias of an external group and returns its id.
+func (io *IdentityOperator) CreateGroupAlias(ctx context.Context, request schema.GroupCreateAliasRequest, token string) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity group alias creation", "alias", request.Name, "accessor", request.MountAccessor)
+
+	resp, err := io.client.GroupCreateAlias(ctx, request, vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	id, _ := resp.Data["id"].(string)
+	return id, nil
+}
+
+func (io *IdentityOperator) UpdateGroupAlias(ctx context.Context, id string, request schema.GroupUpdateAliasByIdRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity group alias update", "id", id)
+
+	_, err := io.client.GroupUpdateAliasById(ctx, id, request, vault.WithToken(token))
+	return err
+}
+
+func (io *IdentityOperator) DeleteGroupAlias(ctx context.Context, id string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting identity group alias deletion", "id", id)
+
+	_, err := io.client.GroupDeleteAliasById(ctx, id, vault.WithToken(token))
+	return err
+}
+
+func identityAlias(data map[string]interface{}) *IdentityAlias {
+	alias := &IdentityAlias{}
+	alias.ID, _ = data["id"].(string)
+	alias.Name, _ = data["name"].(string)
+	alias.MountAccessor, _ = data["mount_accessor"].(string)
+	alias.CanonicalID, _ = data["canonical_id"].(string)
+	return alias
+}
+
+func (vc *VaultClient) EntityReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.EntityReadByName(ctx, name, options...)
+}
+
+func (vc *VaultClient) EntityDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.EntityDeleteByName(ctx, name, options...)
+}
+
+func (vc *VaultClient) EntityReadAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.EntityReadAliasById(ctx, id, options...)
+}
+
+func (vc *VaultClient) EntityCreateAlias(ctx context.Context, request schema.EntityCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.EntityCreateAlias(ctx, request, options...)
+}
+
+func (vc *VaultClient) EntityDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.EntityDeleteAliasById(ctx, id, options...)
+}
+
+func (vc *VaultClient) GroupReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.GroupReadByName(ctx, name, options...)
+}
+
+func (vc *VaultClient) GroupDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.GroupDeleteByName(ctx, name, options...)
+}
+
+func (vc *VaultClient) GroupCreateAlias(ctx context.Context, request schema.GroupCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.GroupCreateAlias(ctx, request, options...)
+}
+
+func (vc *VaultClient) GroupUpdateAliasById(ctx context.Context, id string, request schema.GroupUpdateAliasByIdRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.GroupUpdateAliasById(ctx, id, request, options...)
+}
+
+func (vc *VaultClient) GroupDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Identity.GroupDeleteAliasById(ctx, id, options...)
+}
@@ -0,0 +1,177 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) EntityReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if _, ok := mc.writes["identity/entity/name/"+name]; !ok {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{"id": "entity-" + name, "name": name}}, nil
+}
+
+func (mc *MockVaultClient) EntityDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.writes, "identity/entity/name/"+name)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) EntityReadAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	alias, ok := mc.identityAliases[id]
+	if !ok {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+	return &vault.Response[map[string]interface{}]{Data: alias}, nil
+}
+
+func (mc *MockVaultClient) EntityCreateAlias(ctx context.Context, request schema.EntityCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return mc.createAlias(request.Name, request.MountAccessor, request.CanonicalId)
+}
+
+func (mc *MockVaultClient) EntityDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.identityAliases, id)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) GroupReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	group, ok := mc.writes["identity/group/name/"+name]
+	if !ok {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+
+	data := map[string]interface{}{"id": "group-" + name, "type": group["type"], "alias": map[string]interface{}{}}
+	for _, alias := range mc.identityAliases {
+		if alias["canonical_id"] == "group-"+name {
+			data["alias"] = alias
+		}
+	}
+	return &vault.Response[map[string]interface{}]{Data: data}, nil
+}
+
+func (mc *MockVaultClient) GroupDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.writes, "identity/group/name/"+name)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) GroupCreateAlias(ctx context.Context, request schema.GroupCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return mc.createAlias(request.Name, request.MountAccessor, request.CanonicalId)
+}
+
+func (mc *MockVaultClient) GroupUpdateAliasById(ctx context.Context, id string, request schema.GroupUpdateAliasByIdRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.identityAliases[id]["name"] = request.Name
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) GroupDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.identityAliases, id)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) createAlias(name string, accessor string, canonicalID string) (*vault.Response[map[string]interface{}], error) {
+	if mc.identityAliases == nil {
+		mc.identityAliases = map[string]map[string]interface{}{}
+	}
+	id := fmt.Sprintf("alias-%d", len(mc.identityAliases)+1)
+	mc.identityAliases[id] = map[string]interface{}{
+		"id":             id,
+		"name":           name,
+		"mount_accessor": accessor,
+		"canonical_id":   canonicalID,
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{"id": id, "canonical_id": canonicalID}}, nil
+}
+
+func TestIdentityEntity(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewIdentityOperator(client)
+
+	id, err := op.ReadEntityID(ctx, "jane", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, id)
+
+	err = op.WriteEntity(ctx, "jane", map[string]interface{}{"policies": []string{}, "disabled": false}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, client.writes["identity/entity/name/jane"]["policies"])
+
+	id, err = op.ReadEntityID(ctx, "jane", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "entity-jane", id)
+
+	assert.NoError(t, op.DeleteEntity(ctx, "jane", "token"))
+	id, err = op.ReadEntityID(ctx, "jane", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, id)
+}
+
+func TestIdentityEntityAlias(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewIdentityOperator(client)
+
+	alias, err := op.ReadEntityAlias(ctx, "alias-1", "token")
+	assert.NoError(t, err)
+	assert.Nil(t, alias)
+
+	id, err := op.CreateEntityAlias(ctx, schema.EntityCreateAliasRequest{
+		Name:          "jane",
+		MountAccessor: "auth_userpass_1234",
+		CanonicalId:   "entity-jane",
+	}, "token")
+	assert.NoError(t, err)
+
+	alias, err = op.ReadEntityAlias(ctx, id, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, &IdentityAlias{ID: id, Name: "jane", MountAccessor: "auth_userpass_1234", CanonicalID: "entity-jane"}, alias)
+
+	err = op.UpdateEntityAlias(ctx, id, map[string]interface{}{
+		"canonical_id":    "entity-john",
+		"custom_metadata": map[string]interface{}{},
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "entity-john", client.writes["identity/entity-alias/id/"+id]["canonical_id"])
+	assert.Equal(t, map[string]interface{}{}, client.writes["identity/entity-alias/id/"+id]["custom_metadata"])
+
+	assert.NoError(t, op.DeleteEntityAlias(ctx, id, "token"))
+	assert.Empty(t, client.identityAliases)
+}
+
+func TestIdentityExternalGroup(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewIdentityOperator(client)
+
+	group, err := op.ReadGroup(ctx, "admins", "token")
+	assert.NoError(t, err)
+	assert.Nil(t, group)
+
+	err = op.WriteGroup(ctx, "admins", map[string]interface{}{"type": "external", "policies": []string{"admin"}}, "token")
+	assert.NoError(t, err)
+
+	group, err = op.ReadGroup(ctx, "admins", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "external", group.Type)
+	assert.Nil(t, group.Alias)
+
+	_, err = op.CreateGroupAlias(ctx, schema.GroupCreateAliasRequest{
+		Name:          "cn=admins,ou=groups,dc=example,dc=org",
+		MountAccessor: "auth_ldap_1234",
+		CanonicalId:   group.ID,
+	}, "token")
+	assert.NoError(t, err)
+
+	group, err = op.ReadGroup(ctx, "admins", "token")
+	assert.NoError(t, err)
+	if assert.NotNil(t, group.Alias) {
+		assert.Equal(t, "auth_ldap_1234", group.Alias.MountAccessor)
+	}
+
+	assert.NoError(t, op.DeleteGroupAlias(ctx, group.Alias.ID, "token"))
+	assert.NoError(t, op.DeleteGroup(ctx, "admins", "token"))
+}
@@ -0,0 +1,44 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type JWTAuthOperator struct {
+	client VaultClientI
+}
+
+func NewJWTAuthOperator(client VaultClientI) *JWTAuthOperator {
+	return &JWTAuthOperator{client: client}
+}
+
+func (jo *JWTAuthOperator) ConfigureAuth(ctx context.Context, mountPath string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Configuring jwt/oidc auth method", "mount", mountPath, "issuer", data["bound_issuer"])
+
+	_, err := jo.client.Write(ctx, "auth/"+mountPath+"/config", data, vault.WithToken(token))
+	return err
+}
+
+func (jo *JWTAuthOperator) CreateOrUpdateRole(ctx context.Context, mountPath string, roleName string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting jwt role creation or update", "mount", mountPath, "role", roleName)
+
+	_, err := jo.client.Write(ctx, "auth/"+mountPath+"/role/"+roleName, data, vault.WithToken(token))
+	return err
+}
+
+func (jo *JWTAuthOperator) DeleteRole(ctx context.Context, mountPath string, roleName string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting jwt role deletion", "mount", mountPath, "role", roleName)
+
+	_, err := jo.client.JwtDeleteRole(ctx, roleName, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) JwtDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Auth.JwtDeleteRole(ctx, roleName, options...)
+}
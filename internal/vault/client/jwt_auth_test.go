@@ -0,0 +1,55 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) JwtDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestJWTConfigureAuthStaticKeys(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewJWTAuthOperator(client)
+
+	err := op.ConfigureAuth(context.Background(), "jwt", map[string]interface{}{
+		"jwt_validation_pubkeys": []string{"-----BEGIN PUBLIC KEY-----\n...\n-----END PUBLIC KEY-----"},
+		"bound_issuer":           "https://ci.example.com",
+		"default_role":           "ci",
+		"oidc_discovery_url":     "",
+	}, "token")
+	assert.NoError(t, err)
+	config := client.writes["auth/jwt/config"]
+	assert.Len(t, config["jwt_validation_pubkeys"], 1)
+	assert.Equal(t, "https://ci.example.com", config["bound_issuer"])
+	assert.Equal(t, "", config["oidc_discovery_url"])
+}
+
+func TestJWTRoleCreation(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewJWTAuthOperator(client)
+
+	err := op.CreateOrUpdateRole(context.Background(), "jwt", "ci", map[string]interface{}{
+		"role_type":       "jwt",
+		"user_claim":      "sub",
+		"bound_audiences": []string{"vault"},
+		"bound_claims":    map[string]interface{}{"project": []string{"infra"}},
+	}, "token")
+	assert.NoError(t, err)
+	role := client.writes["auth/jwt/role/ci"]
+	assert.Equal(t, "jwt", role["role_type"])
+	assert.Equal(t, []string{"vault"}, role["bound_audiences"])
+	assert.Contains(t, role["bound_claims"], "project")
+}
+
+func TestJWTRoleDeletion(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewJWTAuthOperator(client)
+
+	err := op.DeleteRole(context.Background(), "jwt", "ci", "token")
+	assert.NoError(t, err)
+}
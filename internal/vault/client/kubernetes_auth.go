@@ -0,0 +1,48 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type KubernetesAuthOperator struct {
+	client VaultClientI
+}
+
+func NewKubernetesAuthOperator(client VaultClientI) *KubernetesAuthOperator {
+	return &KubernetesAuthOperator{client: client}
+}
+
+// ConfigureAuth writes the config with a raw body. The typed requests of the
+// client omit false, zero and empty values, so a setting removed from a spec
+// could never be reset through them. Roles, and the configs and roles of the
+// jwt, ldap and ssh operators, are written raw for the same reason.
+func (ko *KubernetesAuthOperator) ConfigureAuth(ctx context.Context, mountPath string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Configuring kubernetes auth method", "mount", mountPath, "host", data["kubernetes_host"])
+
+	_, err := ko.client.Write(ctx, "auth/"+mountPath+"/config", data, vault.WithToken(token))
+	return err
+}
+
+func (ko *KubernetesAuthOperator) CreateOrUpdateRole(ctx context.Context, mountPath string, roleName string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting kubernetes role creation or update", "mount", mountPath, "role", roleName)
+
+	_, err := ko.client.Write(ctx, "auth/"+mountPath+"/role/"+roleName, data, vault.WithToken(token))
+	return err
+}
+
+func (ko *KubernetesAuthOperator) DeleteRole(ctx context.Context, mountPath string, roleName string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting kubernetes role deletion", "mount", mountPath, "role", roleName)
+
+	_, err := ko.client.KubernetesDeleteAuthRole(ctx, roleName, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) KubernetesDeleteAuthRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Auth.KubernetesDeleteAuthRole(ctx, roleName, options...)
+}
@@ -0,0 +1,53 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) KubernetesDeleteAuthRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestKubernetesConfigureAuth(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewKubernetesAuthOperator(client)
+
+	err := op.ConfigureAuth(context.Background(), "kubernetes", map[string]interface{}{
+		"kubernetes_host":      "https://kubernetes.default.svc",
+		"issuer":               "https://kubernetes.default.svc.cluster.local",
+		"disable_local_ca_jwt": false,
+	}, "token")
+	assert.NoError(t, err)
+	config := client.writes["auth/kubernetes/config"]
+	assert.Equal(t, "https://kubernetes.default.svc", config["kubernetes_host"])
+	assert.Equal(t, "https://kubernetes.default.svc.cluster.local", config["issuer"])
+	assert.Equal(t, false, config["disable_local_ca_jwt"])
+}
+
+func TestKubernetesRoleCreation(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewKubernetesAuthOperator(client)
+
+	err := op.CreateOrUpdateRole(context.Background(), "kubernetes", "my-app", map[string]interface{}{
+		"bound_service_account_names":      []string{"my-app"},
+		"bound_service_account_namespaces": []string{"default"},
+		"token_policies":                   []string{"default"},
+		"token_no_default_policy":          false,
+	}, "token")
+	assert.NoError(t, err)
+	role := client.writes["auth/kubernetes/role/my-app"]
+	assert.Equal(t, []string{"my-app"}, role["bound_service_account_names"])
+	assert.Equal(t, false, role["token_no_default_policy"])
+}
+
+func TestKubernetesRoleDeletion(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewKubernetesAuthOperator(client)
+
+	err := op.DeleteRole(context.Background(), "kubernetes", "my-app", "token")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,63 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (ao *AuthOperator) ConfigureLDAP(ctx context.Context, mountPath string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Configuring ldap auth method", "mount", mountPath, "url", data["url"])
+
+	_, err := ao.client.Write(ctx, "auth/"+mountPath+"/config", data, vault.WithToken(token))
+	return err
+}
+
+func (ao *AuthOperator) CreateOrUpdateLDAPGroup(ctx context.Context, mountPath string, groupName string, policies []string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ldap group creation or update", "mount", mountPath, "group", groupName)
+
+	data := map[string]interface{}{
+		"policies": NonNilStrings(policies),
+	}
+	_, err := ao.client.Write(ctx, "auth/"+mountPath+"/groups/"+groupName, data, vault.WithToken(token))
+	return err
+}
+
+func (ao *AuthOperator) DeleteLDAPGroup(ctx context.Context, mountPath string, groupName string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ldap group deletion", "mount", mountPath, "group", groupName)
+
+	_, err := ao.client.LdapDeleteGroup(ctx, groupName, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (ao *AuthOperator) CreateOrUpdateLDAPUser(ctx context.Context, mountPath string, username string, groups []string, policies []string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ldap user creation or update", "mount", mountPath, "user", username)
+
+	data := map[string]interface{}{
+		"groups":   NonNilStrings(groups),
+		"policies": NonNilStrings(policies),
+	}
+	_, err := ao.client.Write(ctx, "auth/"+mountPath+"/users/"+username, data, vault.WithToken(token))
+	return err
+}
+
+func (ao *AuthOperator) DeleteLDAPUser(ctx context.Context, mountPath string, username string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ldap user deletion", "mount", mountPath, "user", username)
+
+	_, err := ao.client.LdapDeleteUser(ctx, username, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) LdapDeleteGroup(ctx context.Context, groupName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Auth.LdapDeleteGroup(ctx, groupName, options...)
+}
+
+func (vc *VaultClient) LdapDeleteUser(ctx context.Context, username string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Auth.LdapDeleteUser(ctx, username, options...)
+}
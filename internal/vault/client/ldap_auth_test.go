@@ -0,0 +1,60 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) LdapDeleteGroup(ctx context.Context, groupName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) LdapDeleteUser(ctx context.Context, username string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestLDAPConfigure(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewAuthOperator(client)
+
+	err := op.ConfigureLDAP(context.Background(), "ldap", map[string]interface{}{
+		"url":          "ldap://openldap:389",
+		"binddn":       "cn=admin,dc=example,dc=org",
+		"bindpass":     "admin",
+		"userdn":       "ou=users,dc=example,dc=org",
+		"insecure_tls": false,
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "ldap://openldap:389", client.writes["auth/ldap/config"]["url"])
+	assert.Equal(t, "admin", client.writes["auth/ldap/config"]["bindpass"])
+	assert.Equal(t, false, client.writes["auth/ldap/config"]["insecure_tls"])
+}
+
+func TestLDAPGroupLifecycle(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewAuthOperator(client)
+
+	err := op.CreateOrUpdateLDAPGroup(context.Background(), "ldap", "developers", []string{"dev"}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, client.writes["auth/ldap/groups/developers"]["policies"])
+
+	err = op.CreateOrUpdateLDAPGroup(context.Background(), "ldap", "developers", nil, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, client.writes["auth/ldap/groups/developers"]["policies"])
+
+	err = op.DeleteLDAPGroup(context.Background(), "ldap", "developers", "token")
+	assert.NoError(t, err)
+}
+
+func TestLDAPUserCreation(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewAuthOperator(client)
+
+	err := op.CreateOrUpdateLDAPUser(context.Background(), "ldap", "alice", []string{"developers"}, []string{"admin"}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"developers"}, client.writes["auth/ldap/users/alice"]["groups"])
+	assert.Equal(t, []string{"admin"}, client.writes["auth/ldap/users/alice"]["policies"])
+}
@@ -0,0 +1,107 @@
+package cvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Lease is a secret read from a dynamic path together with its lease.
+type Lease struct {
+	LeaseID   string
+	Duration  time.Duration
+	Renewable bool
+	Data      map[string]string
+}
+
+type LeaseOperator struct {
+	client VaultClientI
+}
+
+func NewLeaseOperator(client VaultClientI) *LeaseOperator {
+	return &LeaseOperator{client: client}
+}
+
+// ReadDynamicSecret reads path, or writes params to it when write is set, and
+// flattens the returned data into strings. Non string values are JSON encoded.
+func (lo *LeaseOperator) ReadDynamicSecret(ctx context.Context, path string, write bool, params map[string]string, token string) (*Lease, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Reading dynamic secret", "path", path)
+
+	var resp *vault.Response[map[string]interface{}]
+	var err error
+	if write {
+		body := make(map[string]interface{}, len(params))
+		for k, v := range params {
+			body[k] = v
+		}
+		resp, err = lo.client.Write(ctx, path, body, vault.WithToken(token))
+	} else {
+		resp, err = lo.client.Read(ctx, path, vault.WithToken(token))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || len(resp.Data) == 0 {
+		return nil, fmt.Errorf("no data returned from %s", path)
+	}
+
+	data := make(map[string]string, len(resp.Data))
+	for k, v := range resp.Data {
+		switch value := v.(type) {
+		case string:
+			data[k] = value
+		default:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode key %s: %w", k, err)
+			}
+			data[k] = strings.TrimSpace(string(encoded))
+		}
+	}
+
+	return &Lease{
+		LeaseID:   resp.LeaseID,
+		Duration:  time.Duration(resp.LeaseDuration) * time.Second,
+		Renewable: resp.Renewable,
+		Data:      data,
+	}, nil
+}
+
+// RenewLease extends the lease by increment and returns the duration Vault
+// granted, which is shorter than requested once the max TTL is close.
+func (lo *LeaseOperator) RenewLease(ctx context.Context, leaseID string, increment time.Duration, token string) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Renewing lease", "leaseId", leaseID)
+
+	resp, err := lo.client.LeasesRenewLease(ctx, schema.LeasesRenewLeaseRequest{
+		LeaseId:   leaseID,
+		Increment: fmt.Sprintf("%ds", int64(increment.Seconds())),
+	}, vault.WithToken(token))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(resp.LeaseDuration) * time.Second, nil
+}
+
+func (lo *LeaseOperator) RevokeLease(ctx context.Context, leaseID string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Revoking lease", "leaseId", leaseID)
+
+	_, err := lo.client.LeasesRevokeLease(ctx, schema.LeasesRevokeLeaseRequest{LeaseId: leaseID}, vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) LeasesRenewLease(ctx context.Context, request schema.LeasesRenewLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.System.LeasesRenewLease(ctx, request, options...)
+}
+
+func (vc *VaultClient) LeasesRevokeLease(ctx context.Context, request schema.LeasesRevokeLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.System.LeasesRevokeLease(ctx, request, options...)
+}
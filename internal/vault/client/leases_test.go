@@ -0,0 +1,62 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) LeasesRenewLease(ctx context.Context, request schema.LeasesRenewLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.renewals = append(mc.renewals, request)
+	return &vault.Response[map[string]interface{}]{LeaseID: request.LeaseId, LeaseDuration: mc.leaseGrant, Renewable: true}, nil
+}
+
+func (mc *MockVaultClient) LeasesRevokeLease(ctx context.Context, request schema.LeasesRevokeLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.revoked = append(mc.revoked, request.LeaseId)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestReadDynamicSecret(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"database/creds/readonly": {
+			LeaseID:       "database/creds/readonly/abc",
+			LeaseDuration: 3600,
+			Renewable:     true,
+			Data: map[string]interface{}{
+				"username": "v-token-readonly",
+				"password": "secret",
+				"ttl":      float64(3600),
+			},
+		},
+	}}
+	op := NewLeaseOperator(client)
+
+	lease, err := op.ReadDynamicSecret(context.Background(), "database/creds/readonly", false, nil, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "database/creds/readonly/abc", lease.LeaseID)
+	assert.Equal(t, time.Hour, lease.Duration)
+	assert.True(t, lease.Renewable)
+	assert.Equal(t, "v-token-readonly", lease.Data["username"])
+	assert.Equal(t, "3600", lease.Data["ttl"])
+
+	_, err = op.ReadDynamicSecret(context.Background(), "database/creds/missing", false, nil, "token")
+	assert.Error(t, err)
+}
+
+func TestRenewAndRevokeLease(t *testing.T) {
+	client := &MockVaultClient{leaseGrant: 1800}
+	op := NewLeaseOperator(client)
+
+	granted, err := op.RenewLease(context.Background(), "database/creds/readonly/abc", time.Hour, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, granted)
+	assert.Equal(t, "3600s", client.renewals[0].Increment)
+
+	err = op.RevokeLease(context.Background(), "database/creds/readonly/abc", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"database/creds/readonly/abc"}, client.revoked)
+}
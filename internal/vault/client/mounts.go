@@ -0,0 +1,75 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+)
+
+// Migration states reported by sys/remount/status.
+const (
+	MigrationInProgress = "in-progress"
+	MigrationSuccess    = "success"
+	MigrationFailure    = "failure"
+)
+
+// MountTypeMismatchError is returned when a path is already mounted with a
+// different type than the requested one. Callers should not touch such a
+// mount, it most likely belongs to somebody else.
+type MountTypeMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e *MountTypeMismatchError) Error() string {
+	return fmt.Sprintf("path %s is already mounted with type %s, expected %s", e.Path, e.Actual, e.Expected)
+}
+
+// mountType looks up path in the data returned by sys/auth or sys/mounts and
+// returns its type, if mounted.
+func mountType(data map[string]interface{}, path string) (string, bool) {
+	entry, ok := data[strings.TrimSuffix(path, "/")+"/"]
+	if !ok {
+		return "", false
+	}
+
+	mount, ok := entry.(map[string]interface{})
+	if !ok {
+		return "", true
+	}
+
+	mType, _ := mount["type"].(string)
+	return mType, true
+}
+
+// remount moves a mount and returns the migration id to poll with remountStatus.
+func remount(ctx context.Context, client VaultClientI, from string, to string, token string) (string, error) {
+	resp, err := client.Remount(ctx, schema.RemountRequest{From: from, To: to}, vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Data.MigrationId, nil
+}
+
+func remountStatus(ctx context.Context, client VaultClientI, migrationID string, token string) (string, error) {
+	resp, err := client.RemountStatus(ctx, migrationID, vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	status, _ := resp.Data.MigrationInfo["status"].(string)
+	return status, nil
+}
+
+func (vc *VaultClient) Remount(ctx context.Context, request schema.RemountRequest, options ...vault.RequestOption) (*vault.Response[schema.RemountResponse], error) {
+	return vc.System.Remount(ctx, request, options...)
+}
+
+func (vc *VaultClient) RemountStatus(ctx context.Context, migrationID string, options ...vault.RequestOption) (*vault.Response[schema.RemountStatusResponse], error) {
+	return vc.System.RemountStatus(ctx, migrationID, options...)
+}
@@ -0,0 +1,45 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (vc *MockVaultClient) Remount(ctx context.Context, request schema.RemountRequest, options ...vault.RequestOption) (*vault.Response[schema.RemountResponse], error) {
+	vc.remounts = append(vc.remounts, request)
+	return &vault.Response[schema.RemountResponse]{
+		Data: schema.RemountResponse{MigrationId: fmt.Sprintf("migration-%d", len(vc.remounts))},
+	}, nil
+}
+
+func (vc *MockVaultClient) RemountStatus(ctx context.Context, migrationID string, options ...vault.RequestOption) (*vault.Response[schema.RemountStatusResponse], error) {
+	return &vault.Response[schema.RemountStatusResponse]{
+		Data: schema.RemountStatusResponse{
+			MigrationId:   migrationID,
+			MigrationInfo: map[string]interface{}{"status": vc.migrationStatus},
+		},
+	}, nil
+}
+
+func TestMountType(t *testing.T) {
+	data := map[string]interface{}{
+		"kv/":    map[string]interface{}{"type": "kv"},
+		"token/": map[string]interface{}{"type": "token"},
+	}
+
+	mType, ok := mountType(data, "kv")
+	assert.True(t, ok)
+	assert.Equal(t, "kv", mType)
+
+	mType, ok = mountType(data, "token/")
+	assert.True(t, ok)
+	assert.Equal(t, "token", mType)
+
+	_, ok = mountType(data, "missing")
+	assert.False(t, ok)
+}
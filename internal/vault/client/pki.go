@@ -0,0 +1,242 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type PKIOperator struct {
+	client VaultClientI
+}
+
+func NewPKIOperator(client VaultClientI) *PKIOperator {
+	return &PKIOperator{client: client}
+}
+
+// FindIssuer looks an issuer up by name and returns its id, or an empty
+// string when the mount has no issuer with that name.
+func (po *PKIOperator) FindIssuer(ctx context.Context, mountPath string, issuerName string, token string) (string, error) {
+	resp, err := po.client.PkiListIssuers(ctx, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			// no issuers at all yet
+			return "", nil
+		}
+		return "", err
+	}
+
+	for id, info := range resp.Data.KeyInfo {
+		details, ok := info.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := details["issuer_name"].(string); name == issuerName {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+func (po *PKIOperator) ReadIssuer(ctx context.Context, mountPath string, issuerRef string, token string) (*schema.PkiReadIssuerResponse, error) {
+	resp, err := po.client.PkiReadIssuer(ctx, issuerRef, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GenerateRoot creates a self signed root CA whose key never leaves Vault.
+func (po *PKIOperator) GenerateRoot(ctx context.Context, mountPath string, request schema.PkiGenerateRootRequest, token string) (*schema.PkiGenerateRootResponse, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Generating pki root CA", "mount", mountPath, "issuer", request.IssuerName)
+
+	resp, err := po.client.PkiGenerateRoot(ctx, "internal", request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// GenerateIntermediateCSR returns the CSR to be signed by the parent CA. The
+// key named request.KeyName is created on first use and reused afterwards, so
+// a CSR generated again still matches a certificate signed for the first one.
+func (po *PKIOperator) GenerateIntermediateCSR(ctx context.Context, mountPath string, request schema.PkiGenerateIntermediateRequest, token string) (*schema.PkiGenerateIntermediateResponse, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Generating pki intermediate CSR", "mount", mountPath, "commonName", request.CommonName)
+
+	exported := "internal"
+	if request.KeyName != "" {
+		keyID, err := po.keyID(ctx, mountPath, request.KeyName, token)
+		if err != nil {
+			return nil, err
+		}
+		if keyID != "" {
+			exported = "existing"
+			request.KeyRef = request.KeyName
+			request.KeyName = ""
+		}
+	}
+
+	resp, err := po.client.PkiGenerateIntermediate(ctx, exported, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// ImportSignedIntermediate imports the signed intermediate certificate (and
+// optionally its chain), names the issuer backed by the key keyRef and
+// returns its id. A certificate imported before is found again among the
+// existing issuers, so an import whose naming failed is completed later.
+func (po *PKIOperator) ImportSignedIntermediate(ctx context.Context, mountPath string, certificate string, keyRef string, issuerName string, token string) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Importing signed pki intermediate", "mount", mountPath, "issuer", issuerName)
+
+	resp, err := po.client.PkiSetSignedIntermediate(ctx, schema.PkiSetSignedIntermediateRequest{Certificate: certificate},
+		vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+
+	keyID, err := po.keyID(ctx, mountPath, keyRef, token)
+	if err != nil {
+		return "", err
+	}
+
+	// the chain may hold the parents too, the intermediate is the issuer using our key
+	issuerID := ""
+	candidates := append(resp.Data.ImportedIssuers, resp.Data.ExistingIssuers...)
+	for _, id := range candidates {
+		issuer, err := po.ReadIssuer(ctx, mountPath, id, token)
+		if err != nil {
+			return "", err
+		}
+		if keyID != "" && issuer.KeyId == keyID {
+			issuerID = id
+			break
+		}
+	}
+	if issuerID == "" {
+		return "", fmt.Errorf("no issuer uses key %s, the certificate does not match the pending CSR", keyRef)
+	}
+
+	_, err = po.client.PkiWriteIssuer(ctx, issuerID, schema.PkiWriteIssuerRequest{IssuerName: issuerName},
+		vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return "", err
+	}
+	return issuerID, nil
+}
+
+// keyID returns the id of the key named keyRef, or an empty string when the
+// mount has no such key.
+func (po *PKIOperator) keyID(ctx context.Context, mountPath string, keyRef string, token string) (string, error) {
+	resp, err := po.client.Read(ctx, mountPath+"/key/"+keyRef, vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return "", nil
+		}
+		return "", err
+	}
+	if resp == nil {
+		return "", nil
+	}
+
+	keyID, _ := resp.Data["key_id"].(string)
+	return keyID, nil
+}
+
+// CreateOrUpdateRole writes a role with a raw request. The typed request
+// drops false booleans, which would make flags like server_flag impossible
+// to turn off.
+func (po *PKIOperator) CreateOrUpdateRole(ctx context.Context, mountPath string, roleName string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting pki role creation or update", "mount", mountPath, "role", roleName)
+
+	_, err := po.client.Write(ctx, mountPath+"/roles/"+roleName, data, vault.WithToken(token))
+	return err
+}
+
+func (po *PKIOperator) DeleteRole(ctx context.Context, mountPath string, roleName string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting pki role deletion", "mount", mountPath, "role", roleName)
+
+	_, err := po.client.PkiDeleteRole(ctx, roleName, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (po *PKIOperator) ConfigureURLs(ctx context.Context, mountPath string, request schema.PkiConfigureUrlsRequest, token string) error {
+	_, err := po.client.PkiConfigureUrls(ctx, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (po *PKIOperator) ConfigureCRL(ctx context.Context, mountPath string, request schema.PkiConfigureCrlRequest, token string) error {
+	_, err := po.client.PkiConfigureCrl(ctx, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (po *PKIOperator) ConfigureIssuers(ctx context.Context, mountPath string, request schema.PkiConfigureIssuersRequest, token string) error {
+	_, err := po.client.PkiConfigureIssuers(ctx, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// IssueCertificate issues a new certificate and private key from the given
+// role. Vault does not keep the private key, it is only returned here.
+func (po *PKIOperator) IssueCertificate(ctx context.Context, mountPath string, roleName string, request schema.PkiIssueWithRoleRequest, token string) (*schema.PkiIssueWithRoleResponse, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Issuing pki certificate", "mount", mountPath, "role", roleName, "commonName", request.CommonName)
+
+	resp, err := po.client.PkiIssueWithRole(ctx, roleName, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (vc *VaultClient) PkiListIssuers(ctx context.Context, options ...vault.RequestOption) (*vault.Response[schema.PkiListIssuersResponse], error) {
+	return vc.Secrets.PkiListIssuers(ctx, options...)
+}
+
+func (vc *VaultClient) PkiReadIssuer(ctx context.Context, issuerRef string, options ...vault.RequestOption) (*vault.Response[schema.PkiReadIssuerResponse], error) {
+	return vc.Secrets.PkiReadIssuer(ctx, issuerRef, options...)
+}
+
+func (vc *VaultClient) PkiWriteIssuer(ctx context.Context, issuerRef string, request schema.PkiWriteIssuerRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiWriteIssuerResponse], error) {
+	return vc.Secrets.PkiWriteIssuer(ctx, issuerRef, request, options...)
+}
+
+func (vc *VaultClient) PkiGenerateRoot(ctx context.Context, exported string, request schema.PkiGenerateRootRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateRootResponse], error) {
+	return vc.Secrets.PkiGenerateRoot(ctx, exported, request, options...)
+}
+
+func (vc *VaultClient) PkiGenerateIntermediate(ctx context.Context, exported string, request schema.PkiGenerateIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateIntermediateResponse], error) {
+	return vc.Secrets.PkiGenerateIntermediate(ctx, exported, request, options...)
+}
+
+func (vc *VaultClient) PkiSetSignedIntermediate(ctx context.Context, request schema.PkiSetSignedIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiSetSignedIntermediateResponse], error) {
+	return vc.Secrets.PkiSetSignedIntermediate(ctx, request, options...)
+}
+
+func (vc *VaultClient) PkiDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.PkiDeleteRole(ctx, roleName, options...)
+}
+
+func (vc *VaultClient) PkiConfigureUrls(ctx context.Context, request schema.PkiConfigureUrlsRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureUrlsResponse], error) {
+	return vc.Secrets.PkiConfigureUrls(ctx, request, options...)
+}
+
+func (vc *VaultClient) PkiConfigureCrl(ctx context.Context, request schema.PkiConfigureCrlRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureCrlResponse], error) {
+	return vc.Secrets.PkiConfigureCrl(ctx, request, options...)
+}
+
+func (vc *VaultClient) PkiConfigureIssuers(ctx context.Context, request schema.PkiConfigureIssuersRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureIssuersResponse], error) {
+	return vc.Secrets.PkiConfigureIssuers(ctx, request, options...)
+}
+
+func (vc *VaultClient) PkiIssueWithRole(ctx context.Context, roleName string, request schema.PkiIssueWithRoleRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiIssueWithRoleResponse], error) {
+	return vc.Secrets.PkiIssueWithRole(ctx, roleName, request, options...)
+}
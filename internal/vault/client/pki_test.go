@@ -0,0 +1,210 @@
+package cvault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) PkiListIssuers(ctx context.Context, options ...vault.RequestOption) (*vault.Response[schema.PkiListIssuersResponse], error) {
+	if len(mc.pkiIssuers) == 0 {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+
+	keyInfo := map[string]interface{}{}
+	for id, name := range mc.pkiIssuers {
+		keyInfo[id] = map[string]interface{}{"issuer_name": name}
+	}
+	return &vault.Response[schema.PkiListIssuersResponse]{Data: schema.PkiListIssuersResponse{KeyInfo: keyInfo}}, nil
+}
+
+func (mc *MockVaultClient) PkiReadIssuer(ctx context.Context, issuerRef string, options ...vault.RequestOption) (*vault.Response[schema.PkiReadIssuerResponse], error) {
+	return &vault.Response[schema.PkiReadIssuerResponse]{
+		Data: schema.PkiReadIssuerResponse{IssuerId: issuerRef, IssuerName: mc.pkiIssuers[issuerRef], KeyId: mc.pkiIssuerKeys[issuerRef]},
+	}, nil
+}
+
+func (mc *MockVaultClient) PkiWriteIssuer(ctx context.Context, issuerRef string, request schema.PkiWriteIssuerRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiWriteIssuerResponse], error) {
+	mc.pkiIssuers[issuerRef] = request.IssuerName
+	return &vault.Response[schema.PkiWriteIssuerResponse]{}, nil
+}
+
+func (mc *MockVaultClient) PkiGenerateRoot(ctx context.Context, exported string, request schema.PkiGenerateRootRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateRootResponse], error) {
+	mc.pkiRoot = request
+	return &vault.Response[schema.PkiGenerateRootResponse]{
+		Data: schema.PkiGenerateRootResponse{IssuerId: "root-id", IssuerName: request.IssuerName, Certificate: "root-pem"},
+	}, nil
+}
+
+func (mc *MockVaultClient) PkiGenerateIntermediate(ctx context.Context, exported string, request schema.PkiGenerateIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateIntermediateResponse], error) {
+	mc.pkiExported = exported
+	mc.pkiIntermediate = request
+	return &vault.Response[schema.PkiGenerateIntermediateResponse]{
+		Data: schema.PkiGenerateIntermediateResponse{Csr: "csr-pem", KeyId: "key-id"},
+	}, nil
+}
+
+// PkiSetSignedIntermediate imports each certificate once, a second import
+// reports it among the existing issuers like Vault does.
+func (mc *MockVaultClient) PkiSetSignedIntermediate(ctx context.Context, request schema.PkiSetSignedIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiSetSignedIntermediateResponse], error) {
+	if mc.pkiIssuers == nil {
+		mc.pkiIssuers = map[string]string{}
+		mc.pkiIssuerKeys = map[string]string{}
+		mc.pkiSigned = map[string]string{}
+	}
+	if id, ok := mc.pkiSigned[request.Certificate]; ok {
+		return &vault.Response[schema.PkiSetSignedIntermediateResponse]{
+			Data: schema.PkiSetSignedIntermediateResponse{ExistingIssuers: []string{id}},
+		}, nil
+	}
+
+	id := fmt.Sprintf("issuer-%d", len(mc.pkiIssuers)+1)
+	mc.pkiIssuers[id] = ""
+	mc.pkiIssuerKeys[id] = "key-id"
+	mc.pkiSigned[request.Certificate] = id
+	return &vault.Response[schema.PkiSetSignedIntermediateResponse]{
+		Data: schema.PkiSetSignedIntermediateResponse{ImportedIssuers: []string{id}},
+	}, nil
+}
+
+func (mc *MockVaultClient) PkiDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) PkiConfigureUrls(ctx context.Context, request schema.PkiConfigureUrlsRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureUrlsResponse], error) {
+	mc.pkiURLs = request
+	return &vault.Response[schema.PkiConfigureUrlsResponse]{}, nil
+}
+
+func (mc *MockVaultClient) PkiConfigureCrl(ctx context.Context, request schema.PkiConfigureCrlRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureCrlResponse], error) {
+	return &vault.Response[schema.PkiConfigureCrlResponse]{}, nil
+}
+
+func (mc *MockVaultClient) PkiConfigureIssuers(ctx context.Context, request schema.PkiConfigureIssuersRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureIssuersResponse], error) {
+	return &vault.Response[schema.PkiConfigureIssuersResponse]{}, nil
+}
+
+func (mc *MockVaultClient) PkiIssueWithRole(ctx context.Context, roleName string, request schema.PkiIssueWithRoleRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiIssueWithRoleResponse], error) {
+	mc.pkiIssued = request
+	return &vault.Response[schema.PkiIssueWithRoleResponse]{
+		Data: schema.PkiIssueWithRoleResponse{Certificate: "leaf-pem", PrivateKey: "key-pem", CaChain: []string{"int-pem", "root-pem"}, SerialNumber: "01:02"},
+	}, nil
+}
+
+func TestPKIFindIssuer(t *testing.T) {
+	ctx := context.Background()
+
+	op := NewPKIOperator(&MockVaultClient{})
+	id, err := op.FindIssuer(ctx, "pki", "root-2025", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, id)
+
+	op = NewPKIOperator(&MockVaultClient{pkiIssuers: map[string]string{"abc": "root-2025", "def": "other"}})
+	id, err = op.FindIssuer(ctx, "pki", "root-2025", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", id)
+}
+
+func TestPKIGenerateRoot(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewPKIOperator(client)
+
+	root, err := op.GenerateRoot(context.Background(), "pki", schema.PkiGenerateRootRequest{
+		CommonName: "example.com",
+		IssuerName: "root-2025",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "root-id", root.IssuerId)
+	assert.Equal(t, "example.com", client.pkiRoot.CommonName)
+}
+
+func TestPKIImportSignedIntermediate(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewPKIOperator(client)
+
+	csr, err := op.GenerateIntermediateCSR(context.Background(), "pki-int", schema.PkiGenerateIntermediateRequest{KeyName: "int-2025", CommonName: "int.example.com"}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "csr-pem", csr.Csr)
+	assert.Equal(t, "internal", client.pkiExported)
+	assert.Equal(t, "int-2025", client.pkiIntermediate.KeyName)
+
+	client.reads = map[string]*vault.Response[map[string]interface{}]{
+		"pki-int/key/int-2025": {Data: map[string]interface{}{"key_id": "key-id"}},
+	}
+	id, err := op.ImportSignedIntermediate(context.Background(), "pki-int", "signed-pem", "int-2025", "int-2025", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "int-2025", client.pkiIssuers[id])
+}
+
+func TestPKIGenerateIntermediateCSRReusesKey(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"pki-int/key/int-2025": {Data: map[string]interface{}{"key_id": "key-id"}},
+	}}
+	op := NewPKIOperator(client)
+
+	_, err := op.GenerateIntermediateCSR(context.Background(), "pki-int", schema.PkiGenerateIntermediateRequest{KeyName: "int-2025", CommonName: "int.example.com"}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "existing", client.pkiExported)
+	assert.Equal(t, "int-2025", client.pkiIntermediate.KeyRef)
+	assert.Empty(t, client.pkiIntermediate.KeyName)
+}
+
+func TestPKIImportSignedIntermediateAlreadyImported(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"pki-int/key/int-2025": {Data: map[string]interface{}{"key_id": "key-id"}},
+	}}
+	op := NewPKIOperator(client)
+
+	// a first import whose issuer was never named
+	_, err := client.PkiSetSignedIntermediate(context.Background(), schema.PkiSetSignedIntermediateRequest{Certificate: "signed-pem"})
+	assert.NoError(t, err)
+
+	id, err := op.ImportSignedIntermediate(context.Background(), "pki-int", "signed-pem", "int-2025", "int-2025", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "issuer-1", id)
+	assert.Equal(t, "int-2025", client.pkiIssuers[id])
+
+	_, err = op.ImportSignedIntermediate(context.Background(), "pki-int", "other-pem", "missing", "int-2025", "token")
+	assert.Error(t, err)
+}
+
+func TestPKIRoleWritesRawData(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewPKIOperator(client)
+
+	err := op.CreateOrUpdateRole(context.Background(), "pki", "web", map[string]interface{}{"client_flag": false}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, false, client.writes["pki/roles/web"]["client_flag"])
+
+	err = op.DeleteRole(context.Background(), "pki", "web", "token")
+	assert.NoError(t, err)
+}
+
+func TestPKIConfigureURLs(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewPKIOperator(client)
+
+	err := op.ConfigureURLs(context.Background(), "pki", schema.PkiConfigureUrlsRequest{
+		IssuingCertificates: []string{"https://vault.example.com/v1/pki/ca"},
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://vault.example.com/v1/pki/ca"}, client.pkiURLs.IssuingCertificates)
+}
+
+func TestPKIIssueCertificate(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewPKIOperator(client)
+
+	cert, err := op.IssueCertificate(context.Background(), "pki", "web", schema.PkiIssueWithRoleRequest{
+		CommonName: "app.example.com",
+		AltNames:   "www.example.com",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "leaf-pem", cert.Certificate)
+	assert.Equal(t, "01:02", cert.SerialNumber)
+	assert.Equal(t, "www.example.com", client.pkiIssued.AltNames)
+}
@@ -0,0 +1,147 @@
+package cvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/vault-client-go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Quota types, as used in sys/quotas/<type>/<name>.
+const (
+	QuotaTypeRateLimit  = "rate-limit"
+	QuotaTypeLeaseCount = "lease-count"
+)
+
+// Quota holds the settings of a rate-limit or lease-count quota. Intervals
+// are in seconds, fields not applying to the quota type stay zero.
+type Quota struct {
+	Path          string
+	Role          string
+	Inheritable   bool
+	Rate          float64
+	Interval      int64
+	BlockInterval int64
+	MaxLeases     int64
+}
+
+type QuotaOperator struct {
+	client VaultClientI
+}
+
+func NewQuotaOperator(client VaultClientI) *QuotaOperator {
+	return &QuotaOperator{client: client}
+}
+
+// ReadQuota returns the quota, or nil when it does not exist.
+func (qo *QuotaOperator) ReadQuota(ctx context.Context, quotaType string, name string, token string) (*Quota, error) {
+	resp, err := qo.client.Read(ctx, quotaPath(quotaType, name), vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+
+	quota := &Quota{}
+	quota.Path, _ = resp.Data["path"].(string)
+	quota.Role, _ = resp.Data["role"].(string)
+	quota.Inheritable, _ = resp.Data["inheritable"].(bool)
+	if quota.Rate, err = float64Field(resp.Data, "rate"); err != nil {
+		return nil, err
+	}
+	for field, target := range map[string]*int64{
+		"interval":       &quota.Interval,
+		"block_interval": &quota.BlockInterval,
+		"max_leases":     &quota.MaxLeases,
+	} {
+		if *target, err = int64Field(resp.Data, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return quota, nil
+}
+
+// EnsureQuota writes the quota when it is missing or differs from desired and
+// reports whether it had to.
+func (qo *QuotaOperator) EnsureQuota(ctx context.Context, quotaType string, name string, desired Quota, token string) (bool, error) {
+	current, err := qo.ReadQuota(ctx, quotaType, name, token)
+	if err != nil {
+		return false, err
+	}
+	if current != nil && quotaEqual(*current, desired) {
+		return false, nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Info("Writing quota", "type", quotaType, "quota", name, "existing", current != nil)
+
+	data := map[string]interface{}{
+		"path":        desired.Path,
+		"role":        desired.Role,
+		"inheritable": desired.Inheritable,
+	}
+	switch quotaType {
+	case QuotaTypeRateLimit:
+		data["rate"] = desired.Rate
+		data["interval"] = desired.Interval
+		data["block_interval"] = desired.BlockInterval
+	case QuotaTypeLeaseCount:
+		data["max_leases"] = desired.MaxLeases
+	}
+
+	_, err = qo.client.Write(ctx, quotaPath(quotaType, name), data, vault.WithToken(token))
+	return true, err
+}
+
+func (qo *QuotaOperator) DeleteQuota(ctx context.Context, quotaType string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Deleting quota", "type", quotaType, "quota", name)
+
+	_, err := qo.client.Delete(ctx, quotaPath(quotaType, name), vault.WithToken(token))
+	return err
+}
+
+func quotaPath(quotaType string, name string) string {
+	return "sys/quotas/" + quotaType + "/" + name
+}
+
+// quotaEqual compares two quotas. Vault appends a slash to mount paths, and
+// a zero interval means its default of one second.
+func quotaEqual(a Quota, b Quota) bool {
+	normalize := func(q Quota) Quota {
+		q.Path = strings.TrimSuffix(q.Path, "/")
+		if q.Rate > 0 && q.Interval == 0 {
+			q.Interval = 1
+		}
+		return q
+	}
+
+	a, b = normalize(a), normalize(b)
+	return a.Path == b.Path && a.Role == b.Role && a.Inheritable == b.Inheritable &&
+		math.Abs(a.Rate-b.Rate) < 1e-6 && a.Interval == b.Interval &&
+		a.BlockInterval == b.BlockInterval && a.MaxLeases == b.MaxLeases
+}
+
+func float64Field(data map[string]interface{}, key string) (float64, error) {
+	switch value := data[key].(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		return value.Float64()
+	case float64:
+		return value, nil
+	case int:
+		return float64(value), nil
+	default:
+		return 0, fmt.Errorf("field %s has unexpected type %T", key, value)
+	}
+}
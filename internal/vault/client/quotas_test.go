@@ -0,0 +1,66 @@
+package cvault
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaCreatedWhenMissing(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewQuotaOperator(client)
+
+	changed, err := op.EnsureQuota(context.Background(), QuotaTypeRateLimit, "global", Quota{Rate: 100, Interval: 1}, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, float64(100), client.writes["sys/quotas/rate-limit/global"]["rate"])
+	assert.NotContains(t, client.writes["sys/quotas/rate-limit/global"], "max_leases")
+}
+
+func TestQuotaDrift(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"sys/quotas/rate-limit/userpass": {Data: map[string]interface{}{
+			"path":           "auth/userpass/",
+			"rate":           json.Number("50"),
+			"interval":       json.Number("1"),
+			"block_interval": json.Number("0"),
+		}},
+	}}
+	op := NewQuotaOperator(client)
+
+	changed, err := op.EnsureQuota(context.Background(), QuotaTypeRateLimit, "userpass", Quota{Path: "auth/userpass", Rate: 50}, "token")
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = op.EnsureQuota(context.Background(), QuotaTypeRateLimit, "userpass", Quota{Path: "auth/userpass", Rate: 50, BlockInterval: 60}, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, int64(60), client.writes["sys/quotas/rate-limit/userpass"]["block_interval"])
+}
+
+func TestLeaseCountQuota(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"sys/quotas/lease-count/db": {Data: map[string]interface{}{
+			"path":       "database/",
+			"max_leases": json.Number("100"),
+		}},
+	}}
+	op := NewQuotaOperator(client)
+
+	quota, err := op.ReadQuota(context.Background(), QuotaTypeLeaseCount, "db", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), quota.MaxLeases)
+
+	changed, err := op.EnsureQuota(context.Background(), QuotaTypeLeaseCount, "db", Quota{Path: "database", MaxLeases: 200}, "token")
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, int64(200), client.writes["sys/quotas/lease-count/db"]["max_leases"])
+
+	assert.NoError(t, op.DeleteQuota(context.Background(), QuotaTypeLeaseCount, "db", "token"))
+	quota, err = op.ReadQuota(context.Background(), QuotaTypeLeaseCount, "db", "token")
+	assert.NoError(t, err)
+	assert.Nil(t, quota)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type SecretEngineOperator struct {
@@ -31,26 +32,81 @@ func (mo *SecretEngineOperator) IsMountEnabled(path string, token string) (bool,
 }
 
 func (mo *SecretEngineOperator) EnableMount(path string, mountType string, token string) error {
-	ok, err := mo.IsMountEnabled(path, token)
+	return mo.EnableMountWithConfig(context.Background(), path, schema.MountsEnableSecretsEngineRequest{Type: mountType}, token)
+}
+
+// EnableMountWithConfig enables the engine described by request at path. The
+// request is only used when the mount does not exist yet, use TuneMount to
+// update an existing one. A mount of another type at path yields a
+// *MountTypeMismatchError.
+func (mo *SecretEngineOperator) EnableMountWithConfig(ctx context.Context, path string, request schema.MountsEnableSecretsEngineRequest, token string) error {
+	mType, ok, err := mo.MountType(path, token)
 	if err != nil {
 		return err
 	}
 
 	if ok {
+		if mType != "" && mType != NormalizeMountType(request.Type) {
+			return &MountTypeMismatchError{Path: path, Expected: request.Type, Actual: mType}
+		}
 		return nil
 	}
 
 	_, err = mo.client.MountEnable(
-		context.Background(),
+		ctx,
 		path,
-		schema.MountsEnableSecretsEngineRequest{
-			Type: mountType,
-		},
+		request,
 		vault.WithToken(token),
 	)
 	return err
 }
 
+// TuneMount applies data to the mount at path through sys/mounts/<path>/tune.
+// The body is written raw, so empty values are sent and reset the setting.
+func (mo *SecretEngineOperator) TuneMount(ctx context.Context, path string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Tuning secret engine", "path", path)
+
+	_, err := mo.client.Write(ctx, "sys/mounts/"+path+"/tune", data, vault.WithToken(token))
+	return err
+}
+
+// MountType returns the type of the engine mounted at path and whether
+// anything is mounted there at all.
+func (mo *SecretEngineOperator) MountType(path string, token string) (string, bool, error) {
+	resp, err := mo.client.ListMounts(context.Background(), vault.WithToken(token))
+	if err != nil {
+		return "", false, err
+	}
+
+	mType, ok := mountType(resp.Data, path)
+	return mType, ok, nil
+}
+
+// RemountMount moves the engine at from to to, keeping its data. It returns
+// the id of the migration Vault runs for it.
+func (mo *SecretEngineOperator) RemountMount(ctx context.Context, from string, to string, token string) (string, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Remounting secret engine", "from", from, "to", to)
+
+	return remount(ctx, mo.client, from, to, token)
+}
+
+// RemountStatus returns the state of a migration started by RemountMount.
+func (mo *SecretEngineOperator) RemountStatus(ctx context.Context, migrationID string, token string) (string, error) {
+	return remountStatus(ctx, mo.client, migrationID, token)
+}
+
+// NormalizeMountType maps the type aliases accepted on enable to the type
+// Vault reports in sys/mounts.
+func NormalizeMountType(mType string) string {
+	switch mType {
+	case "kv-v1", "kv-v2", "generic":
+		return "kv"
+	}
+	return mType
+}
+
 func (mo *SecretEngineOperator) DisableMount(path string, token string) error {
 	_, err := mo.client.MountDisable(
 		context.Background(),
@@ -2,6 +2,7 @@ package cvault
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/vault-client-go"
@@ -10,6 +11,7 @@ import (
 )
 
 func (mc *MockVaultClient) MountEnable(ctx context.Context, path string, request schema.MountsEnableSecretsEngineRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.mountEnabled = request
 	return &vault.Response[map[string]interface{}]{}, nil
 }
 
@@ -21,7 +23,7 @@ func (mc *MockVaultClient) ListMounts(ctx context.Context, options ...vault.Requ
 	// return fake secret path is mounted in the response
 	return &vault.Response[map[string]interface{}]{
 		Data: map[string]interface{}{
-			"secret/": map[string]interface{}{},
+			"secret/": map[string]interface{}{"type": "kv"},
 		},
 	}, nil
 }
@@ -60,4 +62,74 @@ func TestIsMountEnabled(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, tc.expectedBool, enabled)
 	}
-}
\ No newline at end of file
+}
+func TestMountEnablingWithConfig(t *testing.T) {
+	client := &MockVaultClient{}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	err := secretEngOp.EnableMountWithConfig(context.Background(), "kv-v2", schema.MountsEnableSecretsEngineRequest{
+		Type:     "kv",
+		Options:  map[string]interface{}{"version": "2"},
+		SealWrap: true,
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", client.mountEnabled.Options["version"])
+	assert.True(t, client.mountEnabled.SealWrap)
+}
+
+func TestMountEnablingWithConfigExisting(t *testing.T) {
+	client := &MockVaultClient{}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	err := secretEngOp.EnableMountWithConfig(context.Background(), "secret", schema.MountsEnableSecretsEngineRequest{Type: "kv"}, "token")
+	assert.NoError(t, err)
+	assert.Empty(t, client.mountEnabled.Type)
+}
+
+func TestMountTuning(t *testing.T) {
+	client := &MockVaultClient{}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	err := secretEngOp.TuneMount(context.Background(), "secret", map[string]interface{}{
+		"default_lease_ttl":        "1h",
+		"allowed_response_headers": []string{"X-Custom"},
+		"description":              "",
+	}, "token")
+	assert.NoError(t, err)
+	tuned := client.writes["sys/mounts/secret/tune"]
+	assert.Equal(t, "1h", tuned["default_lease_ttl"])
+	assert.Equal(t, []string{"X-Custom"}, tuned["allowed_response_headers"])
+	assert.Contains(t, tuned, "description")
+}
+
+func TestMountEnablingTypeMismatch(t *testing.T) {
+	client := &MockVaultClient{}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	err := secretEngOp.EnableMountWithConfig(context.Background(), "secret", schema.MountsEnableSecretsEngineRequest{Type: "pki"}, "token")
+	var mismatch *MountTypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "kv", mismatch.Actual)
+	assert.Empty(t, client.mountEnabled.Type)
+}
+
+func TestMountEnablingKvAlias(t *testing.T) {
+	client := &MockVaultClient{}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	err := secretEngOp.EnableMountWithConfig(context.Background(), "secret", schema.MountsEnableSecretsEngineRequest{Type: "kv-v2"}, "token")
+	assert.NoError(t, err)
+}
+
+func TestMountRemount(t *testing.T) {
+	client := &MockVaultClient{migrationStatus: MigrationInProgress}
+	secretEngOp := NewSecretEngineOperator(client)
+
+	id, err := secretEngOp.RemountMount(context.Background(), "old-kv", "new-kv", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, []schema.RemountRequest{{From: "old-kv", To: "new-kv"}}, client.remounts)
+
+	status, err := secretEngOp.RemountStatus(context.Background(), id, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, MigrationInProgress, status)
+}
@@ -105,14 +105,70 @@ type MockVaultClient struct {
 	// input
 	secretExists      bool
 	secretRandomError bool
+	mounts            map[string]interface{}
+	migrationStatus   string
+	reads             map[string]*vault.Response[map[string]interface{}]
+	writeResponses    map[string]*vault.Response[map[string]interface{}]
+	leaseGrant        int
 
 	// output
 	secretCreationInvoked int
 	policyCount           int
+	remounts              []schema.RemountRequest
+	authEnabled           []string
+	mountEnabled          schema.MountsEnableSecretsEngineRequest
+	writes                map[string]map[string]interface{}
+	pkiIssuers            map[string]string
+	pkiIssuerKeys         map[string]string
+	pkiSigned             map[string]string
+	pkiIntermediate       schema.PkiGenerateIntermediateRequest
+	pkiExported           string
+	pkiRoot               schema.PkiGenerateRootRequest
+	pkiURLs               schema.PkiConfigureUrlsRequest
+	pkiIssued             schema.PkiIssueWithRoleRequest
+	dbRotations           []string
+	dbRole                schema.DatabaseWriteRoleRequest
+	dbStaticRole          schema.DatabaseWriteStaticRoleRequest
+	renewals              []schema.LeasesRenewLeaseRequest
+	revoked               []string
+	transitKeys           map[string]int64
+	sshCAPublicKey        string
+	totpKeys              map[string]schema.TotpCreateKeyRequest
+	identityAliases       map[string]map[string]interface{}
+	auditDevices          map[string]schema.AuditingEnableDeviceRequest
+	wrapInfo              *vault.ResponseWrapInfo
 }
 
 var _ VaultClientI = (*MockVaultClient)(nil)
 
+func (vc *MockVaultClient) Write(ctx context.Context, path string, body map[string]interface{}, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if vc.writes == nil {
+		vc.writes = map[string]map[string]interface{}{}
+	}
+	vc.writes[path] = body
+	if resp, ok := vc.writeResponses[path]; ok {
+		return resp, nil
+	}
+	return &vault.Response[map[string]interface{}]{WrapInfo: vc.wrapInfo}, nil
+}
+
+func (vc *MockVaultClient) List(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Read(ctx, path, options...)
+}
+
+func (vc *MockVaultClient) Delete(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(vc.writes, path)
+	delete(vc.reads, path)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (vc *MockVaultClient) Read(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if resp, ok := vc.reads[path]; ok {
+		return resp, nil
+	}
+	return nil, &vault.ResponseError{StatusCode: 404}
+}
+
 func (vc *MockVaultClient) ReadInitializationStatus(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
 	return nil, nil
 }
@@ -0,0 +1,83 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type SSHOperator struct {
+	client VaultClientI
+}
+
+func NewSSHOperator(client VaultClientI) *SSHOperator {
+	return &SSHOperator{client: client}
+}
+
+// ReadCAPublicKey returns the public key of the CA, or an empty string when
+// the mount has no signing key yet.
+func (so *SSHOperator) ReadCAPublicKey(ctx context.Context, mountPath string, token string) (string, error) {
+	resp, err := so.client.SshReadCaConfiguration(ctx, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		// vault answers 400 "keys haven't been configured yet"
+		if vault.IsErrorStatus(err, 400) || vault.IsErrorStatus(err, 404) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	publicKey, _ := resp.Data["public_key"].(string)
+	return publicKey, nil
+}
+
+// ConfigureCA generates or imports the signing key. Vault refuses to replace
+// an existing key, DeleteCA has to be called first.
+func (so *SSHOperator) ConfigureCA(ctx context.Context, mountPath string, request schema.SshConfigureCaRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Configuring ssh CA", "mount", mountPath, "generate", request.GenerateSigningKey)
+
+	_, err := so.client.SshConfigureCa(ctx, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (so *SSHOperator) DeleteCA(ctx context.Context, mountPath string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Deleting ssh CA", "mount", mountPath)
+
+	_, err := so.client.SshDeleteCaConfiguration(ctx, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (so *SSHOperator) CreateOrUpdateRole(ctx context.Context, mountPath string, roleName string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ssh role creation or update", "mount", mountPath, "role", roleName)
+
+	_, err := so.client.Write(ctx, mountPath+"/roles/"+roleName, data, vault.WithToken(token))
+	return err
+}
+
+func (so *SSHOperator) DeleteRole(ctx context.Context, mountPath string, roleName string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting ssh role deletion", "mount", mountPath, "role", roleName)
+
+	_, err := so.client.SshDeleteRole(ctx, roleName, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) SshReadCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.SshReadCaConfiguration(ctx, options...)
+}
+
+func (vc *VaultClient) SshConfigureCa(ctx context.Context, request schema.SshConfigureCaRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.SshConfigureCa(ctx, request, options...)
+}
+
+func (vc *VaultClient) SshDeleteCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.SshDeleteCaConfiguration(ctx, options...)
+}
+
+func (vc *VaultClient) SshDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.SshDeleteRole(ctx, roleName, options...)
+}
@@ -0,0 +1,78 @@
+package cvault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) SshReadCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if mc.sshCAPublicKey == "" {
+		return nil, &vault.ResponseError{StatusCode: 400}
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{"public_key": mc.sshCAPublicKey}}, nil
+}
+
+func (mc *MockVaultClient) SshConfigureCa(ctx context.Context, request schema.SshConfigureCaRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if mc.sshCAPublicKey != "" {
+		return nil, errors.New("keys are already configured")
+	}
+	mc.sshCAPublicKey = request.PublicKey
+	if request.GenerateSigningKey {
+		mc.sshCAPublicKey = "ssh-rsa generated"
+	}
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) SshDeleteCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.sshCAPublicKey = ""
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) SshDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestSSHCA(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewSSHOperator(client)
+
+	publicKey, err := op.ReadCAPublicKey(ctx, "ssh", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, publicKey)
+
+	err = op.ConfigureCA(ctx, "ssh", schema.SshConfigureCaRequest{GenerateSigningKey: true}, "token")
+	assert.NoError(t, err)
+
+	publicKey, err = op.ReadCAPublicKey(ctx, "ssh", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "ssh-rsa generated", publicKey)
+
+	// replacing the key requires deleting it first
+	err = op.ConfigureCA(ctx, "ssh", schema.SshConfigureCaRequest{PublicKey: "ssh-ed25519 imported"}, "token")
+	assert.Error(t, err)
+	assert.NoError(t, op.DeleteCA(ctx, "ssh", "token"))
+	assert.NoError(t, op.ConfigureCA(ctx, "ssh", schema.SshConfigureCaRequest{PublicKey: "ssh-ed25519 imported"}, "token"))
+	assert.Equal(t, "ssh-ed25519 imported", client.sshCAPublicKey)
+}
+
+func TestSSHRole(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewSSHOperator(client)
+
+	err := op.CreateOrUpdateRole(context.Background(), "ssh", "engineers", map[string]interface{}{
+		"key_type":                "ca",
+		"allowed_users":           "ubuntu,admin",
+		"allow_user_certificates": false,
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "ubuntu,admin", client.writes["ssh/roles/engineers"]["allowed_users"])
+	assert.Equal(t, false, client.writes["ssh/roles/engineers"]["allow_user_certificates"])
+
+	assert.NoError(t, op.DeleteRole(context.Background(), "ssh", "engineers", "token"))
+}
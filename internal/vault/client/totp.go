@@ -0,0 +1,75 @@
+package cvault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// TOTPExport holds what Vault returns once when generating an exported key.
+type TOTPExport struct {
+	Barcode string
+	URL     string
+}
+
+type TOTPOperator struct {
+	client VaultClientI
+}
+
+func NewTOTPOperator(client VaultClientI) *TOTPOperator {
+	return &TOTPOperator{client: client}
+}
+
+func (to *TOTPOperator) IsKeyCreated(ctx context.Context, mountPath string, name string, token string) (bool, error) {
+	_, err := to.client.TotpReadKey(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateKey creates the key. For generated and exported keys the barcode and
+// url are returned, Vault never hands them out again.
+func (to *TOTPOperator) CreateKey(ctx context.Context, mountPath string, name string, request schema.TotpCreateKeyRequest, token string) (*TOTPExport, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting totp key creation", "mount", mountPath, "key", name, "generate", request.Generate)
+
+	resp, err := to.client.TotpCreateKey(ctx, name, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	export := &TOTPExport{}
+	if resp != nil {
+		export.Barcode, _ = resp.Data["barcode"].(string)
+		export.URL, _ = resp.Data["url"].(string)
+	}
+
+	return export, nil
+}
+
+func (to *TOTPOperator) DeleteKey(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting totp key deletion", "mount", mountPath, "key", name)
+
+	_, err := to.client.TotpDeleteKey(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+func (vc *VaultClient) TotpReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TotpReadKey(ctx, name, options...)
+}
+
+func (vc *VaultClient) TotpCreateKey(ctx context.Context, name string, request schema.TotpCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TotpCreateKey(ctx, name, request, options...)
+}
+
+func (vc *VaultClient) TotpDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TotpDeleteKey(ctx, name, options...)
+}
@@ -0,0 +1,76 @@
+package cvault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) TotpReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	key, ok := mc.totpKeys[name]
+	if !ok {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{"issuer": key.Issuer}}, nil
+}
+
+func (mc *MockVaultClient) TotpCreateKey(ctx context.Context, name string, request schema.TotpCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if mc.totpKeys == nil {
+		mc.totpKeys = map[string]schema.TotpCreateKeyRequest{}
+	}
+	mc.totpKeys[name] = request
+	if !request.Generate || !request.Exported {
+		return &vault.Response[map[string]interface{}]{}, nil
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{
+		"barcode": "iVBORw0KGgo=",
+		"url":     "otpauth://totp/" + request.Issuer + ":" + request.AccountName,
+	}}, nil
+}
+
+func (mc *MockVaultClient) TotpDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.totpKeys, name)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestTOTPGeneratedKey(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewTOTPOperator(client)
+
+	created, err := op.IsKeyCreated(ctx, "totp", "legacy", "token")
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	export, err := op.CreateKey(ctx, "totp", "legacy", schema.TotpCreateKeyRequest{
+		Generate:    true,
+		Exported:    true,
+		Issuer:      "Example",
+		AccountName: "ops@example.com",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth://totp/Example:ops@example.com", export.URL)
+	assert.NotEmpty(t, export.Barcode)
+
+	created, err = op.IsKeyCreated(ctx, "totp", "legacy", "token")
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	assert.NoError(t, op.DeleteKey(ctx, "totp", "legacy", "token"))
+	assert.Empty(t, client.totpKeys)
+}
+
+func TestTOTPProviderKey(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewTOTPOperator(client)
+
+	export, err := op.CreateKey(context.Background(), "totp", "provider", schema.TotpCreateKeyRequest{
+		Url: "otpauth://totp/Provider:ops?secret=JBSWY3DPEHPK3PXP",
+	}, "token")
+	assert.NoError(t, err)
+	assert.Empty(t, export.URL)
+	assert.Equal(t, "otpauth://totp/Provider:ops?secret=JBSWY3DPEHPK3PXP", client.totpKeys["provider"].Url)
+}
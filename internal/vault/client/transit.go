@@ -0,0 +1,124 @@
+package cvault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// TransitKey is the subset of a transit key read back from Vault.
+type TransitKey struct {
+	Type                 string
+	LatestVersion        int64
+	MinAvailableVersion  int64
+	MinDecryptionVersion int64
+	MinEncryptionVersion int64
+}
+
+type TransitOperator struct {
+	client VaultClientI
+}
+
+func NewTransitOperator(client VaultClientI) *TransitOperator {
+	return &TransitOperator{client: client}
+}
+
+// ReadKey returns the key, or nil when it does not exist.
+func (to *TransitOperator) ReadKey(ctx context.Context, mountPath string, name string, token string) (*TransitKey, error) {
+	resp, err := to.client.TransitReadKey(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	if err != nil {
+		if vault.IsErrorStatus(err, 404) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	key := &TransitKey{}
+	key.Type, _ = resp.Data["type"].(string)
+	for field, target := range map[string]*int64{
+		"latest_version":         &key.LatestVersion,
+		"min_available_version":  &key.MinAvailableVersion,
+		"min_decryption_version": &key.MinDecryptionVersion,
+		"min_encryption_version": &key.MinEncryptionVersion,
+	} {
+		if *target, err = int64Field(resp.Data, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func (to *TransitOperator) CreateKey(ctx context.Context, mountPath string, name string, request schema.TransitCreateKeyRequest, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting transit key creation", "mount", mountPath, "key", name, "type", request.Type)
+
+	_, err := to.client.TransitCreateKey(ctx, name, request, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// ConfigureKey writes the key config with a raw request. The typed request
+// drops false booleans and zero versions, which would make deletion_allowed
+// impossible to turn off again.
+func (to *TransitOperator) ConfigureKey(ctx context.Context, mountPath string, name string, data map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting transit key configuration", "mount", mountPath, "key", name)
+
+	_, err := to.client.Write(ctx, mountPath+"/keys/"+name+"/config", data, vault.WithToken(token))
+	return err
+}
+
+func (to *TransitOperator) RotateKey(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Rotating transit key", "mount", mountPath, "key", name)
+
+	_, err := to.client.TransitRotateKey(ctx, name, schema.TransitRotateKeyRequest{}, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// DeleteKey deletes the key, Vault refuses it unless deletion_allowed is set.
+func (to *TransitOperator) DeleteKey(ctx context.Context, mountPath string, name string, token string) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Starting transit key deletion", "mount", mountPath, "key", name)
+
+	_, err := to.client.TransitDeleteKey(ctx, name, vault.WithMountPath(mountPath), vault.WithToken(token))
+	return err
+}
+
+// int64Field reads a numeric field of a response decoded with UseNumber.
+func int64Field(data map[string]interface{}, key string) (int64, error) {
+	switch value := data[key].(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		return value.Int64()
+	case float64:
+		return int64(value), nil
+	case int:
+		return int64(value), nil
+	case int64:
+		return value, nil
+	default:
+		return 0, fmt.Errorf("field %s has unexpected type %T", key, value)
+	}
+}
+
+func (vc *VaultClient) TransitReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TransitReadKey(ctx, name, options...)
+}
+
+func (vc *VaultClient) TransitCreateKey(ctx context.Context, name string, request schema.TransitCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TransitCreateKey(ctx, name, request, options...)
+}
+
+func (vc *VaultClient) TransitRotateKey(ctx context.Context, name string, request schema.TransitRotateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TransitRotateKey(ctx, name, request, options...)
+}
+
+func (vc *VaultClient) TransitDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	return vc.Secrets.TransitDeleteKey(ctx, name, options...)
+}
@@ -0,0 +1,74 @@
+package cvault
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func (mc *MockVaultClient) TransitReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	version, ok := mc.transitKeys[name]
+	if !ok {
+		return nil, &vault.ResponseError{StatusCode: 404}
+	}
+	return &vault.Response[map[string]interface{}]{Data: map[string]interface{}{
+		"type":                   "aes256-gcm96",
+		"latest_version":         json.Number(strconv.FormatInt(version, 10)),
+		"min_available_version":  json.Number("0"),
+		"min_decryption_version": json.Number("1"),
+		"min_encryption_version": json.Number("0"),
+	}}, nil
+}
+
+func (mc *MockVaultClient) TransitCreateKey(ctx context.Context, name string, request schema.TransitCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	if mc.transitKeys == nil {
+		mc.transitKeys = map[string]int64{}
+	}
+	mc.transitKeys[name] = 1
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) TransitRotateKey(ctx context.Context, name string, request schema.TransitRotateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	mc.transitKeys[name]++
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func (mc *MockVaultClient) TransitDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
+	delete(mc.transitKeys, name)
+	return &vault.Response[map[string]interface{}]{}, nil
+}
+
+func TestTransitKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := &MockVaultClient{}
+	op := NewTransitOperator(client)
+
+	key, err := op.ReadKey(ctx, "transit", "app", "token")
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+
+	err = op.CreateKey(ctx, "transit", "app", schema.TransitCreateKeyRequest{Type: "aes256-gcm96"}, "token")
+	assert.NoError(t, err)
+
+	err = op.RotateKey(ctx, "transit", "app", "token")
+	assert.NoError(t, err)
+
+	key, err = op.ReadKey(ctx, "transit", "app", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "aes256-gcm96", key.Type)
+	assert.Equal(t, int64(2), key.LatestVersion)
+	assert.Equal(t, int64(1), key.MinDecryptionVersion)
+
+	err = op.ConfigureKey(ctx, "transit", "app", map[string]interface{}{"deletion_allowed": false}, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, false, client.writes["transit/keys/app/config"]["deletion_allowed"])
+
+	err = op.DeleteKey(ctx, "transit", "app", "token")
+	assert.NoError(t, err)
+	assert.Empty(t, client.transitKeys)
+}
@@ -2,9 +2,11 @@ package cvault
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type UserPassOperator struct {
@@ -15,27 +17,62 @@ func NewUserPassOperator(client VaultClientI) *UserPassOperator {
 	return &UserPassOperator{client: client}
 }
 
-func (uo *UserPassOperator) CreateUser(mountPath string, username string, password string, policies []string, token string) error {
+// EnsureUser creates the user or rewrites the settings of an existing one.
+// The password of an existing user is only sent when updatePassword is set,
+// so Vault keeps the current one otherwise.
+func (uo *UserPassOperator) EnsureUser(ctx context.Context, mountPath string, username string, password string, updatePassword bool, settings map[string]interface{}, token string) error {
+	logger := log.FromContext(ctx)
+
 	ok, err := uo.IsUserCreated(uo.client, mountPath, username, token)
 	if err != nil {
 		return err
 	}
 
-	if ok {
-		return nil
+	data := map[string]interface{}{}
+	for key, value := range settings {
+		data[key] = value
+	}
+	if !ok || updatePassword {
+		data["password"] = password
 	}
 
-	_, err = uo.client.CreateUserPass(
-		context.Background(),
-		username,
-		schema.UserpassWriteUserRequest{
-			Password: password,
-			Policies: policies,
-		},
-		vault.WithToken(token),
-		vault.WithMountPath(mountPath),
-	)
-	return err
+	_, err = uo.client.Write(ctx, fmt.Sprintf("auth/%s/users/%s", mountPath, username), data, vault.WithToken(token))
+	if err != nil {
+		return err
+	}
+
+	logger.Info("userpass user synchronized", "mount", mountPath, "user", username, "created", !ok, "passwordUpdated", ok && updatePassword)
+	return nil
+}
+
+// Length bounds of passwords generated without a policy.
+const (
+	defaultPasswordLength = 24
+	minPasswordLength     = 8
+)
+
+// GeneratePassword returns a password generated by the named Vault password
+// policy or, without a policy, a random alphanumeric one of the given length.
+// A length below minPasswordLength falls back to defaultPasswordLength.
+func (uo *UserPassOperator) GeneratePassword(ctx context.Context, policy string, length int, token string) (string, error) {
+	if policy == "" {
+		if length < minPasswordLength {
+			length = defaultPasswordLength
+		}
+		return generateRandomString(length), nil
+	}
+
+	resp, err := uo.client.Read(ctx, fmt.Sprintf("sys/policies/password/%s/generate", policy), vault.WithToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate password with policy %s: %w", policy, err)
+	}
+
+	password, ok := resp.Data["password"].(string)
+	if !ok || password == "" {
+		return "", fmt.Errorf("password policy %s returned no password", policy)
+	}
+
+	return password, nil
 }
 
 func (uo *UserPassOperator) DeleteUserPass(mountPath string, username string, token string) error {
@@ -2,9 +2,11 @@ package cvault
 
 import (
 	"context"
+	"testing"
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func (mc *MockVaultClient) CreateUserPass(ctx context.Context, username string, request schema.UserpassWriteUserRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
@@ -22,3 +24,47 @@ func (mc *MockVaultClient) ListUserPass(ctx context.Context, options ...vault.Re
 func (mc *MockVaultClient) DeleteUserPass(ctx context.Context, username string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error) {
 	return &vault.Response[map[string]interface{}]{}, nil
 }
+
+func TestUserPassEnsureUser(t *testing.T) {
+	client := &MockVaultClient{}
+	op := NewUserPassOperator(client)
+	settings := map[string]interface{}{"token_policies": []string{"default"}}
+
+	// new users always get the password
+	err := op.EnsureUser(context.Background(), "userpass", "user3", "secret", false, settings, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", client.writes["auth/userpass/users/user3"]["password"])
+	assert.Equal(t, []string{"default"}, client.writes["auth/userpass/users/user3"]["token_policies"])
+
+	// existing users keep their password unless asked otherwise
+	err = op.EnsureUser(context.Background(), "userpass", "user1", "secret", false, settings, "token")
+	assert.NoError(t, err)
+	assert.NotContains(t, client.writes["auth/userpass/users/user1"], "password")
+	assert.NotContains(t, settings, "password")
+
+	err = op.EnsureUser(context.Background(), "userpass", "user1", "rotated", true, settings, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", client.writes["auth/userpass/users/user1"]["password"])
+}
+
+func TestUserPassGeneratePassword(t *testing.T) {
+	client := &MockVaultClient{reads: map[string]*vault.Response[map[string]interface{}]{
+		"sys/policies/password/strong/generate": {Data: map[string]interface{}{"password": "from-policy"}},
+	}}
+	op := NewUserPassOperator(client)
+
+	password, err := op.GeneratePassword(context.Background(), "", 32, "token")
+	assert.NoError(t, err)
+	assert.Len(t, password, 32)
+
+	password, err = op.GeneratePassword(context.Background(), "", 0, "token")
+	assert.NoError(t, err)
+	assert.Len(t, password, defaultPasswordLength)
+
+	password, err = op.GeneratePassword(context.Background(), "strong", 32, "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "from-policy", password)
+
+	_, err = op.GeneratePassword(context.Background(), "missing", 32, "token")
+	assert.Error(t, err)
+}
@@ -6,11 +6,18 @@ import (
 
 	"github.com/hashicorp/vault-client-go"
 	"github.com/hashicorp/vault-client-go/schema"
-
-	vapi "github.com/hashicorp/vault/api"
 )
 
+// VaultClientI is the single Vault client used by every operator. Endpoints
+// without a typed wrapper go through the generic Read, Write, List and Delete
+// calls, which share the client's TLS, timeout, retry and namespace settings.
 type VaultClientI interface {
+	// Generic
+	Read(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	Write(ctx context.Context, path string, body map[string]interface{}, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	List(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	Delete(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
 	// System
 	ReadInitializationStatus(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 	Initialize(ctx context.Context, request schema.InitializeRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
@@ -18,6 +25,15 @@ type VaultClientI interface {
 	Unseal(ctx context.Context, request schema.UnsealRequest, options ...vault.RequestOption) (*vault.Response[schema.UnsealResponse], error)
 	ReadHealthStatus(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 
+	// Audit Devices
+	AuditingListEnabledDevices(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	AuditingEnableDevice(ctx context.Context, path string, request schema.AuditingEnableDeviceRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	AuditingDisableDevice(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// Leases
+	LeasesRenewLease(ctx context.Context, request schema.LeasesRenewLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	LeasesRevokeLease(ctx context.Context, request schema.LeasesRevokeLeaseRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
 	// Secrets
 	MountsEnableSecretsEngine(ctx context.Context, path string, request schema.MountsEnableSecretsEngineRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 	KvV2Read(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[schema.KvV2ReadResponse], error)
@@ -38,16 +54,78 @@ type VaultClientI interface {
 	MountDisable(ctx context.Context, path string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 	MountEnable(ctx context.Context, path string, request schema.MountsEnableSecretsEngineRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 
+	// Mounts
+	Remount(ctx context.Context, request schema.RemountRequest, options ...vault.RequestOption) (*vault.Response[schema.RemountResponse], error)
+	RemountStatus(ctx context.Context, migrationID string, options ...vault.RequestOption) (*vault.Response[schema.RemountStatusResponse], error)
+
 	// Userpass Auth Method
 	CreateUserPass(ctx context.Context, username string, request schema.UserpassWriteUserRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 	ListUserPass(ctx context.Context, options ...vault.RequestOption) (*vault.Response[schema.StandardListResponse], error)
 	DeleteUserPass(ctx context.Context, username string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 
 	// AppRole Auth Method
-	CreateAppRoleService(ctx context.Context, roleName string, secretIDTTL string, policies []string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
-	WriteAppRoleWithContext(ctx context.Context, path string, roleName string, data map[string]interface{}, ep string, token string) (*vapi.Secret, error)
 	GetAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[schema.AppRoleReadRoleIdResponse], error)
 	DeleteAppRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// Kubernetes Auth Method
+	KubernetesDeleteAuthRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// JWT/OIDC Auth Method
+	JwtDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// LDAP Auth Method
+	LdapDeleteGroup(ctx context.Context, groupName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	LdapDeleteUser(ctx context.Context, username string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// PKI Secret Engine
+	PkiListIssuers(ctx context.Context, options ...vault.RequestOption) (*vault.Response[schema.PkiListIssuersResponse], error)
+	PkiReadIssuer(ctx context.Context, issuerRef string, options ...vault.RequestOption) (*vault.Response[schema.PkiReadIssuerResponse], error)
+	PkiWriteIssuer(ctx context.Context, issuerRef string, request schema.PkiWriteIssuerRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiWriteIssuerResponse], error)
+	PkiGenerateRoot(ctx context.Context, exported string, request schema.PkiGenerateRootRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateRootResponse], error)
+	PkiGenerateIntermediate(ctx context.Context, exported string, request schema.PkiGenerateIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiGenerateIntermediateResponse], error)
+	PkiSetSignedIntermediate(ctx context.Context, request schema.PkiSetSignedIntermediateRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiSetSignedIntermediateResponse], error)
+	PkiDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	PkiConfigureUrls(ctx context.Context, request schema.PkiConfigureUrlsRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureUrlsResponse], error)
+	PkiConfigureCrl(ctx context.Context, request schema.PkiConfigureCrlRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureCrlResponse], error)
+	PkiConfigureIssuers(ctx context.Context, request schema.PkiConfigureIssuersRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiConfigureIssuersResponse], error)
+	PkiIssueWithRole(ctx context.Context, roleName string, request schema.PkiIssueWithRoleRequest, options ...vault.RequestOption) (*vault.Response[schema.PkiIssueWithRoleResponse], error)
+
+	// Database Secret Engine
+	DatabaseDeleteConnectionConfiguration(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	DatabaseRotateRootCredentials(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	DatabaseWriteRole(ctx context.Context, name string, request schema.DatabaseWriteRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	DatabaseDeleteRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	DatabaseWriteStaticRole(ctx context.Context, name string, request schema.DatabaseWriteStaticRoleRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	DatabaseDeleteStaticRole(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// Transit Secret Engine
+	TransitReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	TransitCreateKey(ctx context.Context, name string, request schema.TransitCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	TransitRotateKey(ctx context.Context, name string, request schema.TransitRotateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	TransitDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// SSH Secret Engine
+	SshReadCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	SshConfigureCa(ctx context.Context, request schema.SshConfigureCaRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	SshDeleteCaConfiguration(ctx context.Context, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	SshDeleteRole(ctx context.Context, roleName string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// TOTP Secret Engine
+	TotpReadKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	TotpCreateKey(ctx context.Context, name string, request schema.TotpCreateKeyRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	TotpDeleteKey(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+
+	// Identity
+	EntityReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	EntityDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	EntityReadAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	EntityCreateAlias(ctx context.Context, request schema.EntityCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	EntityDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	GroupReadByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	GroupDeleteByName(ctx context.Context, name string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	GroupCreateAlias(ctx context.Context, request schema.GroupCreateAliasRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	GroupUpdateAliasById(ctx context.Context, id string, request schema.GroupUpdateAliasByIdRequest, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
+	GroupDeleteAliasById(ctx context.Context, id string, options ...vault.RequestOption) (*vault.Response[map[string]interface{}], error)
 }
 
 type VaultClient struct {
@@ -92,26 +170,45 @@ func (vc *VaultClient) KvV2Delete(ctx context.Context, path string, options ...v
 	return vc.Secrets.KvV2Delete(ctx, path, options...)
 }
 
-type VaultOption func() vault.ClientOption
+type clientConfig struct {
+	options   []vault.ClientOption
+	namespace string
+}
+
+type VaultOption func(*clientConfig)
 
 func GetClient(url string, options ...VaultOption) (VaultClientI, error) {
 
-	clientOptions := []vault.ClientOption{vault.WithAddress(url)}
+	config := &clientConfig{options: []vault.ClientOption{vault.WithAddress(url)}}
 	for _, vo := range options {
-		clientOptions = append(clientOptions, vo())
+		vo(config)
 	}
 
 	client, err := vault.New(
-		clientOptions...,
+		config.options...,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if config.namespace != "" {
+		if err := client.SetNamespace(config.namespace); err != nil {
+			return nil, err
+		}
+	}
+
 	return &VaultClient{Client: client}, nil
 }
 
+// CloseIdleConnections releases the pooled connections of a client that is
+// no longer used.
+func (vc *VaultClient) CloseIdleConnections() {
+	if httpClient := vc.Configuration().HTTPClient; httpClient != nil {
+		httpClient.CloseIdleConnections()
+	}
+}
+
 type VaultOperator struct {
 	secretOperator *SecretOperator
 	client         VaultClientI
@@ -124,7 +221,33 @@ func GetVaultOperator(vclient VaultClientI, secretOp *SecretOperator) *VaultOper
 }
 
 func WithTimeout(timeout int) VaultOption {
-	return func() vault.ClientOption {
-		return vault.WithRequestTimeout(time.Duration(timeout) * time.Second)
+	return func(c *clientConfig) {
+		c.options = append(c.options, vault.WithRequestTimeout(time.Duration(timeout)*time.Second))
+	}
+}
+
+// WithTLS sets the CA bundle, client certificate and server name used to
+// reach Vault.
+func WithTLS(tls vault.TLSConfiguration) VaultOption {
+	return func(c *clientConfig) {
+		c.options = append(c.options, vault.WithTLS(tls))
+	}
+}
+
+// WithRetries sets how many times a request failing with a 5xx or 412 is
+// retried. A negative value disables retries.
+func WithRetries(retries int) VaultOption {
+	return func(c *clientConfig) {
+		retry := vault.DefaultConfiguration().RetryConfiguration
+		retry.RetryMax = retries
+		c.options = append(c.options, vault.WithRetryConfiguration(retry))
+	}
+}
+
+// WithNamespace sends every request to the given Vault namespace unless the
+// request overrides it.
+func WithNamespace(namespace string) VaultOption {
+	return func(c *clientConfig) {
+		c.namespace = namespace
 	}
 }
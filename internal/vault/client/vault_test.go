@@ -1,9 +1,13 @@
 package cvault
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/vault-client-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,3 +30,25 @@ func TestGetVaultClient(t *testing.T) {
 	assert.Equal(t, "https://something2", client.Configuration().Address)
 	assert.Equal(t, 7*time.Second, client.Configuration().RequestTimeout)
 }
+
+func TestGetVaultClientOptions(t *testing.T) {
+
+	var namespace string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace = r.Header.Get("X-Vault-Namespace")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	clientInterface, err := GetClient(server.URL, WithRetries(5), WithNamespace("team-a"),
+		WithTLS(vault.TLSConfiguration{ServerName: "vault.internal"}))
+	require.NoError(t, err)
+	client, ok := clientInterface.(*VaultClient)
+	require.True(t, ok)
+	assert.Equal(t, 5, client.Configuration().RetryConfiguration.RetryMax)
+	assert.Equal(t, "vault.internal", client.Configuration().TLS.ServerName)
+
+	_, err = clientInterface.List(context.Background(), "secret/metadata")
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", namespace)
+}
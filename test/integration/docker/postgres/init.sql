@@ -0,0 +1,3 @@
+-- static_app is handed over to a Vault static role, which owns its password
+CREATE ROLE static_app WITH LOGIN PASSWORD 'static-app-password';
+GRANT CONNECT ON DATABASE app TO static_app;
@@ -5,9 +5,12 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const appRoleName = "my-role"
@@ -16,9 +19,13 @@ func TestAppRoleCreate(t *testing.T) {
 	for range 3 {
 		client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
 		assert.NoError(t, err)
-		op := cvault.NewAppRoleOperator(client, vaultAddress)
+		op := cvault.NewAppRoleOperator(client)
 
-		err = op.CreateorUpdateAppRole(context.Background(), "approle", appRoleName, 3700, []string{"default"}, os.Getenv("VAULT_TOKEN"))
+		_, err = op.EnsureAppRole(context.Background(), "approle", appRoleName, cvault.AppRoleConfig{
+			BindSecretID:  true,
+			SecretIDTTL:   3700,
+			TokenPolicies: []string{"default"},
+		}, os.Getenv("VAULT_TOKEN"))
 		assert.NoError(t, err)
 	}
 }
@@ -27,7 +34,7 @@ func TestGetAppRole(t *testing.T) {
 	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
 	assert.NoError(t, err)
 
-	op := cvault.NewAppRoleOperator(client, vaultAddress)
+	op := cvault.NewAppRoleOperator(client)
 	created, err := op.IsAppRoleCreated(context.Background(), "approle", appRoleName, os.Getenv("VAULT_TOKEN"))
 	assert.NoError(t, err)
 	assert.True(t, created)
@@ -36,7 +43,7 @@ func TestGetAppRole(t *testing.T) {
 func TestDeleteAppRole(t *testing.T) {
 	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
 	assert.NoError(t, err)
-	op := cvault.NewAppRoleOperator(client, vaultAddress)
+	op := cvault.NewAppRoleOperator(client)
 	err = op.DeleteAppRole(context.Background(), "approle", appRoleName, os.Getenv("VAULT_TOKEN"))
 	assert.NoError(t, err)
 }
@@ -44,10 +51,129 @@ func TestDeleteAppRole(t *testing.T) {
 func TestGenerateAppRoleSecretID(t *testing.T) {
 	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
 	assert.NoError(t, err)
-	op := cvault.NewAppRoleOperator(client, vaultAddress)
+	op := cvault.NewAppRoleOperator(client)
 
-	secretId, err := op.GenerateAppRoleSecretID(context.Background(), appRoleName, "approle", os.Getenv("VAULT_TOKEN"))
+	secretId, err := op.GenerateAppRoleSecretID(context.Background(), appRoleName, "approle", 0, os.Getenv("VAULT_TOKEN"))
 	assert.NoError(t, err)
-	log.Printf("Generated SecretID: %s", secretId)
+	if assert.NotNil(t, secretId) {
+		log.Printf("Generated SecretID accessor: %s", secretId.Accessor)
+	}
+
+}
+func TestAppRoleSecretIDAccessor(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	require.NoError(t, err)
+	op := cvault.NewAppRoleOperator(client)
+
+	_, err = op.EnsureAppRole(ctx, "approle", "rotation-it", cvault.AppRoleConfig{
+		BindSecretID:  true,
+		SecretIDTTL:   3600,
+		TokenPolicies: []string{"default"},
+	}, os.Getenv("VAULT_TOKEN"))
+	require.NoError(t, err)
+
+	secretId, err := op.GenerateAppRoleSecretID(ctx, "approle", "rotation-it", 0, os.Getenv("VAULT_TOKEN"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), secretId.TTL)
+
+	info, err := op.LookupSecretIDAccessor(ctx, "approle", "rotation-it", secretId.Accessor, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, info) {
+		assert.WithinDuration(t, info.CreationTime.Add(time.Hour), info.ExpirationTime, time.Second)
+	}
+
+	err = op.DestroySecretIDAccessor(ctx, "approle", "rotation-it", secretId.Accessor, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	info, err = op.LookupSecretIDAccessor(ctx, "approle", "rotation-it", secretId.Accessor, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	// destroying twice is fine
+	err = op.DestroySecretIDAccessor(ctx, "approle", "rotation-it", secretId.Accessor, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.DeleteAppRole(ctx, "approle", "rotation-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestAppRoleDriftCorrection(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAppRoleOperator(client)
+
+	desired := cvault.AppRoleConfig{
+		RoleID:             "drift-it-role-id",
+		BindSecretID:       true,
+		SecretIDTTL:        3600,
+		SecretIDNumUses:    5,
+		SecretIDBoundCIDRs: []string{"10.0.0.0/8"},
+		TokenPolicies:      []string{"default"},
+		TokenTTL:           600,
+		TokenMaxTTL:        1200,
+		TokenType:          "service",
+	}
+
+	changed, err := op.EnsureAppRole(ctx, "approle", "drift-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = op.EnsureAppRole(ctx, "approle", "drift-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	roleID, err := op.GetRoleId(ctx, "drift-it", "approle", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Equal(t, "drift-it-role-id", roleID)
+
+	// changed behind the operator's back
+	_, err = client.Write(ctx, "auth/approle/role/drift-it", map[string]interface{}{"token_ttl": 60}, vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+
+	changed, err = op.EnsureAppRole(ctx, "approle", "drift-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	err = op.DeleteAppRole(ctx, "approle", "drift-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestAppRoleWrappedSecretID(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAppRoleOperator(client)
 
-}
\ No newline at end of file
+	_, err = op.EnsureAppRole(ctx, "approle", "wrapped-it", cvault.AppRoleConfig{
+		BindSecretID:  true,
+		SecretIDTTL:   3600,
+		TokenPolicies: []string{"default"},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	secretId, err := op.GenerateAppRoleSecretID(ctx, "approle", "wrapped-it", 5*time.Minute, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Empty(t, secretId.SecretID)
+	assert.NotEmpty(t, secretId.WrappingToken)
+	assert.Equal(t, int64(3600), secretId.TTL)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), secretId.WrappingExpiration, time.Minute)
+
+	valid, err := op.LookupWrappingToken(ctx, secretId.WrappingToken, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	unwrapped, err := client.Write(ctx, "sys/wrapping/unwrap", nil, vault.WithToken(secretId.WrappingToken))
+	assert.NoError(t, err)
+	if assert.NotNil(t, unwrapped) {
+		assert.Equal(t, secretId.Accessor, unwrapped.Data["secret_id_accessor"])
+	}
+
+	valid, err = op.LookupWrappingToken(ctx, secretId.WrappingToken, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	err = op.DeleteAppRole(ctx, "approle", "wrapped-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
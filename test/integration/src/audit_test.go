@@ -0,0 +1,45 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const auditPath = "file-it"
+
+func TestAuditDevice(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	op := cvault.NewAuditOperator(client)
+	err = op.EnableDevice(ctx, auditPath, schema.AuditingEnableDeviceRequest{
+		Type:        "file",
+		Description: "integration test",
+		Options: map[string]interface{}{
+			"file_path":     "discard",
+			"hmac_accessor": "false",
+		},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	devices, err := op.ListDevices(ctx, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.Contains(t, devices, auditPath) {
+		assert.Equal(t, "file", devices[auditPath].Type)
+		assert.Equal(t, "discard", devices[auditPath].Options["file_path"])
+		assert.Equal(t, "false", devices[auditPath].Options["hmac_accessor"])
+	}
+
+	err = op.DisableDevice(ctx, auditPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	devices, err = op.ListDevices(ctx, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.NotContains(t, devices, auditPath)
+}
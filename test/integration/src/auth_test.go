@@ -1,8 +1,11 @@
 package src
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
 	"github.com/stretchr/testify/assert"
@@ -41,3 +44,50 @@ func TestAuthDisable(t *testing.T) {
 	err = op.DisableAuthMethod("kubernetes", os.Getenv("VAULT_TOKEN"))
 	assert.NoError(t, err)
 }
+
+func TestAuthEnableTypeMismatch(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAuthOperator(client)
+
+	err = op.EnableAuthMethod("mismatch-it", "userpass", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.EnableAuthMethod("mismatch-it", "approle", os.Getenv("VAULT_TOKEN"))
+	var mismatch *cvault.MountTypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+
+	err = op.DisableAuthMethod("mismatch-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestAuthRemount(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAuthOperator(client)
+
+	err = op.EnableAuthMethod("remount-src-it", "userpass", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	migrationID, err := op.RemountAuthMethod(context.Background(), "remount-src-it", "remount-dst-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	status := cvault.MigrationInProgress
+	for range 20 {
+		status, err = op.RemountStatus(context.Background(), migrationID, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+		if status != cvault.MigrationInProgress {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	assert.Equal(t, cvault.MigrationSuccess, status)
+
+	mType, ok, err := op.AuthMethodType("remount-dst-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "userpass", mType)
+
+	err = op.DisableAuthMethod("remount-dst-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
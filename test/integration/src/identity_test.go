@@ -0,0 +1,98 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const identityAuthPath = "userpass-identity-it"
+
+func TestIdentityEntitiesAndGroups(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	authOp := cvault.NewAuthOperator(client)
+	err = authOp.EnableAuthMethod(identityAuthPath, "userpass", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	accessor, err := authOp.MountAccessor(identityAuthPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accessor)
+
+	op := cvault.NewIdentityOperator(client)
+	err = op.WriteEntity(ctx, "jane-it", map[string]interface{}{
+		"policies": []string{"default"},
+		"metadata": map[string]interface{}{"team": "platform"},
+		"disabled": false,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	entityID, err := op.ReadEntityID(ctx, "jane-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, entityID)
+
+	aliasID, err := op.CreateEntityAlias(ctx, schema.EntityCreateAliasRequest{
+		Name:          "jane",
+		MountAccessor: accessor,
+		CanonicalId:   entityID,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	alias, err := op.ReadEntityAlias(ctx, aliasID, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, alias) {
+		assert.Equal(t, entityID, alias.CanonicalID)
+		assert.Equal(t, accessor, alias.MountAccessor)
+	}
+
+	err = op.WriteGroup(ctx, "developers-it", map[string]interface{}{
+		"type":              "internal",
+		"policies":          []string{"default"},
+		"member_entity_ids": []string{entityID},
+		"member_group_ids":  []string{},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	group, err := op.ReadGroup(ctx, "developers-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, group) {
+		assert.Equal(t, "internal", group.Type)
+		assert.Nil(t, group.Alias)
+	}
+
+	err = op.WriteGroup(ctx, "external-it", map[string]interface{}{"type": "external"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	external, err := op.ReadGroup(ctx, "external-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	_, err = op.CreateGroupAlias(ctx, schema.GroupCreateAliasRequest{
+		Name:          "admins",
+		MountAccessor: accessor,
+		CanonicalId:   external.ID,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	external, err = op.ReadGroup(ctx, "external-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, external.Alias) {
+		assert.Equal(t, "admins", external.Alias.Name)
+	}
+
+	for _, name := range []string{"developers-it", "external-it"} {
+		err = op.DeleteGroup(ctx, name, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+	}
+	err = op.DeleteEntityAlias(ctx, aliasID, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	err = op.DeleteEntity(ctx, "jane-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = authOp.DisableAuthMethod(identityAuthPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
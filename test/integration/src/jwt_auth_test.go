@@ -0,0 +1,99 @@
+package src
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"testing"
+	"time"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	jwtAuthPath = "jwt-it"
+	jwtIssuer   = "https://issuer.example.com"
+)
+
+// signJWT builds a RS256 token by hand so the test needs no external IdP.
+func signJWT(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	enc := base64.RawURLEncoding
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	assert.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	assert.NoError(t, err)
+
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+
+	return unsigned + "." + enc.EncodeToString(signature)
+}
+
+func TestJWTAuthStaticKeys(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	pubPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	err = cvault.NewAuthOperator(client).EnableAuthMethod(jwtAuthPath, "jwt", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewJWTAuthOperator(client)
+	err = op.ConfigureAuth(context.Background(), jwtAuthPath, map[string]interface{}{
+		"jwt_validation_pubkeys": []string{pubPEM},
+		"bound_issuer":           jwtIssuer,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.CreateOrUpdateRole(context.Background(), jwtAuthPath, "my-app", map[string]interface{}{
+		"role_type":       "jwt",
+		"user_claim":      "sub",
+		"bound_audiences": []string{"vault"},
+		"bound_claims":    map[string]interface{}{"team": []string{"platform"}},
+		"token_policies":  []string{"default"},
+		"token_ttl":       "1h",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	now := time.Now()
+	token := signJWT(t, key, map[string]interface{}{
+		"iss":  jwtIssuer,
+		"sub":  "my-app",
+		"aud":  "vault",
+		"team": "platform",
+		"iat":  now.Unix(),
+		"nbf":  now.Add(-time.Minute).Unix(),
+		"exp":  now.Add(5 * time.Minute).Unix(),
+	})
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	resp, err := vc.Auth.JwtLogin(context.Background(), schema.JwtLoginRequest{Jwt: token, Role: "my-app"}, vault.WithMountPath(jwtAuthPath))
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.NotEmpty(t, resp.Auth.ClientToken)
+	}
+
+	err = op.DeleteRole(context.Background(), jwtAuthPath, "my-app", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = cvault.NewAuthOperator(client).DisableAuthMethod(jwtAuthPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
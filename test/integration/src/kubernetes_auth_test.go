@@ -0,0 +1,49 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const kubernetesAuthPath = "kubernetes-it"
+
+func TestKubernetesAuthConfigure(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewAuthOperator(client).EnableAuthMethod(kubernetesAuthPath, "kubernetes", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewKubernetesAuthOperator(client)
+	for range 3 {
+		err = op.ConfigureAuth(context.Background(), kubernetesAuthPath, map[string]interface{}{
+			"kubernetes_host":      "https://kubernetes.default.svc",
+			"disable_local_ca_jwt": true,
+		}, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+	}
+}
+
+func TestKubernetesAuthRole(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewKubernetesAuthOperator(client)
+
+	err = op.CreateOrUpdateRole(context.Background(), kubernetesAuthPath, "my-app", map[string]interface{}{
+		"bound_service_account_names":      []string{"my-app"},
+		"bound_service_account_namespaces": []string{"default"},
+		"token_policies":                   []string{"default"},
+		"token_ttl":                        "1h",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.DeleteRole(context.Background(), kubernetesAuthPath, "my-app", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = cvault.NewAuthOperator(client).DisableAuthMethod(kubernetesAuthPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
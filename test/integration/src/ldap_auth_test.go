@@ -0,0 +1,72 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// The ldap tests expect the openldap container from test/integration/docker,
+// seeded with ldap/users.ldif and reachable from Vault as "openldap".
+const (
+	ldapAuthPath = "ldap-it"
+	ldapURL      = "ldap://openldap:389"
+)
+
+func TestLDAPAuthConfigure(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAuthOperator(client)
+
+	err = op.EnableAuthMethod(ldapAuthPath, "ldap", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	for range 3 {
+		err = op.ConfigureLDAP(context.Background(), ldapAuthPath, map[string]interface{}{
+			"url":         ldapURL,
+			"binddn":      "cn=admin,dc=example,dc=org",
+			"bindpass":    "admin",
+			"userdn":      "ou=users,dc=example,dc=org",
+			"userattr":    "uid",
+			"groupdn":     "ou=groups,dc=example,dc=org",
+			"groupattr":   "cn",
+			"groupfilter": "(&(objectClass=groupOfNames)(member={{.UserDN}}))",
+		}, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+	}
+}
+
+func TestLDAPGroupAndUser(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewAuthOperator(client)
+
+	err = op.CreateOrUpdateLDAPGroup(context.Background(), ldapAuthPath, "developers", []string{"dev"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.CreateOrUpdateLDAPUser(context.Background(), ldapAuthPath, "alice", nil, []string{"alice"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	resp, err := vc.Auth.LdapLogin(context.Background(), "alice", schema.LdapLoginRequest{Password: "alice-password"}, vault.WithMountPath(ldapAuthPath))
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Contains(t, resp.Auth.Policies, "dev")
+		assert.Contains(t, resp.Auth.Policies, "alice")
+	}
+
+	err = op.DeleteLDAPUser(context.Background(), ldapAuthPath, "alice", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.DeleteLDAPGroup(context.Background(), ldapAuthPath, "developers", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.DisableAuthMethod(ldapAuthPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
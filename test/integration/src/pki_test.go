@@ -0,0 +1,127 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	pkiRootPath = "pki-root-it"
+	pkiIntPath  = "pki-int-it"
+)
+
+func TestPKIRootAndRole(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).EnableMountWithConfig(ctx, pkiRootPath, schema.MountsEnableSecretsEngineRequest{
+		Type:   "pki",
+		Config: map[string]interface{}{"max_lease_ttl": "87600h"},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewPKIOperator(client)
+	id, err := op.FindIssuer(ctx, pkiRootPath, "root-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Empty(t, id)
+
+	root, err := op.GenerateRoot(ctx, pkiRootPath, schema.PkiGenerateRootRequest{
+		IssuerName: "root-it",
+		CommonName: "example.com Root",
+		Ttl:        "87600h",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	id, err = op.FindIssuer(ctx, pkiRootPath, "root-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Equal(t, root.IssuerId, id)
+
+	err = op.ConfigureURLs(ctx, pkiRootPath, schema.PkiConfigureUrlsRequest{
+		IssuingCertificates: []string{vaultAddress + "/v1/" + pkiRootPath + "/ca"},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.ConfigureCRL(ctx, pkiRootPath, schema.PkiConfigureCrlRequest{Expiry: "72h"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.ConfigureIssuers(ctx, pkiRootPath, schema.PkiConfigureIssuersRequest{Default: "root-it"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.CreateOrUpdateRole(ctx, pkiRootPath, "web", map[string]interface{}{
+		"allowed_domains":  []string{"example.com"},
+		"allow_subdomains": true,
+		"client_flag":      false,
+		"ttl":              "1h",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	role, err := vc.Secrets.PkiReadRole(ctx, "web", vault.WithMountPath(pkiRootPath), vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, role) {
+		assert.False(t, role.Data.ClientFlag)
+		assert.True(t, role.Data.ServerFlag)
+	}
+
+	issued, err := op.IssueCertificate(ctx, pkiRootPath, "web", schema.PkiIssueWithRoleRequest{
+		CommonName: "app.example.com",
+		AltNames:   "www.example.com",
+		Ttl:        "10m",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, issued) {
+		assert.Contains(t, issued.Certificate, "BEGIN CERTIFICATE")
+		assert.NotEmpty(t, issued.PrivateKey)
+		assert.Equal(t, root.Certificate, issued.IssuingCa)
+	}
+
+	err = op.DeleteRole(ctx, pkiRootPath, "web", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestPKIIntermediate(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).EnableMount(pkiIntPath, "pki", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewPKIOperator(client)
+	csr, err := op.GenerateIntermediateCSR(ctx, pkiIntPath, schema.PkiGenerateIntermediateRequest{
+		KeyName:    "intermediate-it",
+		CommonName: "example.com Intermediate",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, csr.Csr)
+
+	// sign with the root of the previous test, standing in for an external CA
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	signed, err := vc.Secrets.PkiRootSignIntermediate(ctx, schema.PkiRootSignIntermediateRequest{
+		Csr:    csr.Csr,
+		Format: "pem_bundle",
+		Ttl:    "43800h",
+	}, vault.WithMountPath(pkiRootPath), vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+
+	issuerID, err := op.ImportSignedIntermediate(ctx, pkiIntPath, signed.Data.Certificate, "intermediate-it", "intermediate-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	id, err := op.FindIssuer(ctx, pkiIntPath, "intermediate-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Equal(t, issuerID, id)
+
+	for _, path := range []string{pkiIntPath, pkiRootPath} {
+		err = cvault.NewSecretEngineOperator(client).DisableMount(path, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+	}
+}
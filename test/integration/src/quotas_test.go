@@ -0,0 +1,49 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// lease count quotas are Vault Enterprise only and not covered here
+func TestRateLimitQuota(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	op := cvault.NewQuotaOperator(client)
+	desired := cvault.Quota{Rate: 250, Interval: 1, BlockInterval: 60}
+
+	changed, err := op.EnsureQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = op.EnsureQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	quota, err := op.ReadQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, quota) {
+		assert.Equal(t, float64(250), quota.Rate)
+		assert.Equal(t, int64(60), quota.BlockInterval)
+	}
+
+	// drift: the quota is changed behind the operator's back
+	_, err = op.EnsureQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", cvault.Quota{Rate: 1}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	changed, err = op.EnsureQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", desired, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	err = op.DeleteQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	quota, err = op.ReadQuota(ctx, cvault.QuotaTypeRateLimit, "rate-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Nil(t, quota)
+}
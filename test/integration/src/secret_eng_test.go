@@ -1,10 +1,15 @@
 package src
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
 	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,3 +51,86 @@ func TestIsMountEnabled(t *testing.T) {
 		assert.Equal(t, tc.expectedBool, enabled)
 	}
 }
+
+func TestMountEnablingWithConfigAndTune(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	secretEngOp := cvault.NewSecretEngineOperator(client)
+
+	err = secretEngOp.EnableMountWithConfig(context.Background(), "tuned-kv-it", schema.MountsEnableSecretsEngineRequest{
+		Type:        "kv",
+		Description: "integration",
+		Options:     map[string]interface{}{"version": "2"},
+		Config:      map[string]interface{}{"default_lease_ttl": "1h"},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = secretEngOp.TuneMount(context.Background(), "tuned-kv-it", map[string]interface{}{
+		"default_lease_ttl":        "2h",
+		"allowed_response_headers": []string{"X-Request-Id"},
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	resp, err := vc.System.MountsReadTuningInformation(context.Background(), "tuned-kv-it", vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, int32(7200), resp.Data.DefaultLeaseTtl)
+		assert.Equal(t, []string{"X-Request-Id"}, resp.Data.AllowedResponseHeaders)
+		assert.Equal(t, "2", resp.Data.Options["version"])
+	}
+
+	err = secretEngOp.DisableMount("tuned-kv-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestMountEnablingTypeMismatch(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	secretEngOp := cvault.NewSecretEngineOperator(client)
+
+	err = secretEngOp.EnableMount("mismatch-mount-it", "kv-v2", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	// kv-v2 is reported as kv, enabling it again is a no-op
+	err = secretEngOp.EnableMount("mismatch-mount-it", "kv-v2", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = secretEngOp.EnableMount("mismatch-mount-it", "transit", os.Getenv("VAULT_TOKEN"))
+	var mismatch *cvault.MountTypeMismatchError
+	assert.True(t, errors.As(err, &mismatch))
+
+	err = secretEngOp.DisableMount("mismatch-mount-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+func TestMountRemount(t *testing.T) {
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	secretEngOp := cvault.NewSecretEngineOperator(client)
+
+	err = secretEngOp.EnableMount("remount-src-kv-it", "kv-v2", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	migrationID, err := secretEngOp.RemountMount(context.Background(), "remount-src-kv-it", "remount-dst-kv-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	status := cvault.MigrationInProgress
+	for range 20 {
+		status, err = secretEngOp.RemountStatus(context.Background(), migrationID, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+		if status != cvault.MigrationInProgress {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	assert.Equal(t, cvault.MigrationSuccess, status)
+
+	enabled, err := secretEngOp.IsMountEnabled("remount-dst-kv-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	err = secretEngOp.DisableMount("remount-dst-kv-it", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
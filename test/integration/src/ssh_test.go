@@ -0,0 +1,85 @@
+package src
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const sshPath = "ssh-it"
+
+func TestSSHCAAndRole(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).EnableMount(sshPath, "ssh", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewSSHOperator(client)
+	publicKey, err := op.ReadCAPublicKey(ctx, sshPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.Empty(t, publicKey)
+
+	err = op.ConfigureCA(ctx, sshPath, schema.SshConfigureCaRequest{
+		GenerateSigningKey: true,
+		KeyType:            "ssh-ed25519",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	publicKey, err = op.ReadCAPublicKey(ctx, sshPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(publicKey, "ssh-ed25519 "))
+
+	err = op.CreateOrUpdateRole(ctx, sshPath, "engineers", map[string]interface{}{
+		"key_type":                "ca",
+		"allow_user_certificates": true,
+		"allowed_users":           "ubuntu",
+		"default_user":            "ubuntu",
+		"allowed_extensions":      "permit-pty",
+		"default_extensions":      map[string]interface{}{"permit-pty": ""},
+		"ttl":                     "5m",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	signed, err := vc.Secrets.SshSignCertificate(ctx, "engineers", schema.SshSignCertificateRequest{
+		PublicKey: userPublicKey(t),
+	}, vault.WithMountPath(sshPath), vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, signed) {
+		assert.Contains(t, signed.Data["signed_key"], "ssh-ed25519-cert-v01@openssh.com")
+	}
+
+	err = op.DeleteRole(ctx, sshPath, "engineers", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	err = op.DeleteCA(ctx, sshPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).DisableMount(sshPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
+
+// userPublicKey returns a fresh ed25519 key in authorized_keys format.
+func userPublicKey(t *testing.T) string {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	var wire []byte
+	for _, field := range [][]byte{[]byte("ssh-ed25519"), public} {
+		wire = binary.BigEndian.AppendUint32(wire, uint32(len(field)))
+		wire = append(wire, field...)
+	}
+	return "ssh-ed25519 " + base64.StdEncoding.EncodeToString(wire)
+}
@@ -0,0 +1,67 @@
+package src
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const totpPath = "totp-it"
+
+func TestTOTPKeys(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).EnableMount(totpPath, "totp", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewTOTPOperator(client)
+	created, err := op.IsKeyCreated(ctx, totpPath, "generated", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	export, err := op.CreateKey(ctx, totpPath, "generated", schema.TotpCreateKeyRequest{
+		Generate:    true,
+		Exported:    true,
+		Issuer:      "Example",
+		AccountName: "ops@example.com",
+		QrSize:      200,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, export) {
+		assert.True(t, strings.HasPrefix(export.URL, "otpauth://totp/Example:ops@example.com"))
+		assert.NotEmpty(t, export.Barcode)
+	}
+
+	created, err = op.IsKeyCreated(ctx, totpPath, "generated", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	_, err = op.CreateKey(ctx, totpPath, "provider", schema.TotpCreateKeyRequest{
+		Url: "otpauth://totp/Provider:ops@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Provider",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	vc, ok := client.(*cvault.VaultClient)
+	assert.True(t, ok)
+	code, err := vc.Secrets.TotpGenerateCode(ctx, "provider", vault.WithMountPath(totpPath), vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, code) {
+		assert.Len(t, code.Data["code"], 6)
+	}
+
+	for _, name := range []string{"generated", "provider"} {
+		err = op.DeleteKey(ctx, totpPath, name, os.Getenv("VAULT_TOKEN"))
+		assert.NoError(t, err)
+	}
+
+	err = cvault.NewSecretEngineOperator(client).DisableMount(totpPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
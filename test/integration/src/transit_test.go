@@ -0,0 +1,56 @@
+package src
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const transitPath = "transit-it"
+
+func TestTransitKey(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).EnableMount(transitPath, "transit", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	op := cvault.NewTransitOperator(client)
+	err = op.CreateKey(ctx, transitPath, "app", schema.TransitCreateKeyRequest{Type: "aes256-gcm96"}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.RotateKey(ctx, transitPath, "app", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = op.ConfigureKey(ctx, transitPath, "app", map[string]interface{}{
+		"min_decryption_version": 2,
+		"auto_rotate_period":     "720h",
+		"deletion_allowed":       false,
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	key, err := op.ReadKey(ctx, transitPath, "app", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, key) {
+		assert.Equal(t, "aes256-gcm96", key.Type)
+		assert.Equal(t, int64(2), key.LatestVersion)
+		assert.Equal(t, int64(2), key.MinDecryptionVersion)
+	}
+
+	// deletion is refused until deletion_allowed is set
+	err = op.DeleteKey(ctx, transitPath, "app", os.Getenv("VAULT_TOKEN"))
+	assert.Error(t, err)
+
+	err = op.ConfigureKey(ctx, transitPath, "app", map[string]interface{}{"deletion_allowed": true}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+	err = op.DeleteKey(ctx, transitPath, "app", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	err = cvault.NewSecretEngineOperator(client).DisableMount(transitPath, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}
@@ -1,10 +1,13 @@
 package src
 
 import (
+	"context"
+	"encoding/json"
 	"os"
 	"testing"
 
 	cvault "github.com/danielnegreiros/vault-operator/internal/vault/client"
+	"github.com/hashicorp/vault-client-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +17,9 @@ func TestUserPassCreate(t *testing.T) {
 		assert.NoError(t, err)
 		op := cvault.NewUserPassOperator(client)
 
-		err = op.CreateUser("userpass", "user2", "changeme", []string{"default"}, os.Getenv("VAULT_TOKEN"))
+		err = op.EnsureUser(context.Background(), "userpass", "user2", "changeme", false, map[string]interface{}{
+			"token_policies": []string{"default"},
+		}, os.Getenv("VAULT_TOKEN"))
 		assert.NoError(t, err)
 	}
 }
@@ -37,3 +42,44 @@ func TestDeleteUserPass(t *testing.T) {
 	err = op.DeleteUserPass("userpass", "user2", os.Getenv("VAULT_TOKEN"))
 	assert.NoError(t, err)
 }
+
+func TestUserPassTokenSettings(t *testing.T) {
+	ctx := context.Background()
+	client, err := cvault.GetClient(vaultAddress, cvault.WithTimeout(5))
+	assert.NoError(t, err)
+	op := cvault.NewUserPassOperator(client)
+
+	err = op.EnsureUser(ctx, "userpass", "user3", "changeme", false, map[string]interface{}{
+		"token_policies":    []string{"default"},
+		"token_ttl":         "1h",
+		"token_bound_cidrs": []string{"10.0.0.0/8"},
+		"token_type":        "service",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	user, err := client.Read(ctx, "auth/userpass/users/user3", vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, user) {
+		assert.Equal(t, json.Number("3600"), user.Data["token_ttl"])
+		assert.Equal(t, "service", user.Data["token_type"])
+	}
+
+	// removed settings are reset
+	err = op.EnsureUser(ctx, "userpass", "user3", "changeme", false, map[string]interface{}{
+		"token_policies":    []string{"default"},
+		"token_ttl":         "",
+		"token_bound_cidrs": []string{},
+		"token_type":        "default",
+	}, os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+
+	user, err = client.Read(ctx, "auth/userpass/users/user3", vault.WithToken(os.Getenv("VAULT_TOKEN")))
+	assert.NoError(t, err)
+	if assert.NotNil(t, user) {
+		assert.Equal(t, json.Number("0"), user.Data["token_ttl"])
+		assert.Empty(t, user.Data["token_bound_cidrs"])
+	}
+
+	err = op.DeleteUserPass("userpass", "user3", os.Getenv("VAULT_TOKEN"))
+	assert.NoError(t, err)
+}